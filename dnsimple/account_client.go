@@ -0,0 +1,726 @@
+package dnsimple
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"strconv"
+	"sync"
+)
+
+// AccountClient is a view of a Client bound to a single account.
+//
+// Its services expose the account-scoped methods of the Client services,
+// without the account ID argument:
+//
+//	account := client.ForAccount("1010")
+//	records, err := account.Zones.ListRecords(ctx, "example.com", nil)
+//
+// is equivalent to
+//
+//	records, err := client.Zones.ListRecords(ctx, "1010", "example.com", nil)
+//
+// Use Client for the methods that are not scoped to an account, such as Tlds.ListTlds.
+type AccountClient struct {
+	// Client is the underlying client.
+	Client *Client
+
+	// AccountID is the ID of the account the view is bound to.
+	AccountID string
+
+	// Services used for talking to the account-scoped parts of the DNSimple API.
+	Billing           *AccountBillingService
+	Certificates      *AccountCertificatesService
+	Contacts          *AccountContactsService
+	DnsAnalytics      *AccountDnsAnalyticsService
+	Domains           *AccountDomainsService
+	Registrar         *AccountRegistrarService
+	Services          *AccountServicesService
+	Templates         *AccountTemplatesService
+	VanityNameServers *AccountVanityNameServersService
+	Webhooks          *AccountWebhooksService
+	Zones             *AccountZonesService
+}
+
+// ForAccount returns a view of the client bound to the given account.
+func (c *Client) ForAccount(accountID string) *AccountClient {
+	a := &AccountClient{Client: c, AccountID: accountID}
+	a.Billing = &AccountBillingService{service: c.Billing, accountID: accountID}
+	a.Certificates = &AccountCertificatesService{service: c.Certificates, accountID: accountID}
+	a.Contacts = &AccountContactsService{service: c.Contacts, accountID: accountID}
+	a.DnsAnalytics = &AccountDnsAnalyticsService{service: c.DnsAnalytics, accountID: accountID}
+	a.Domains = &AccountDomainsService{service: c.Domains, accountID: accountID}
+	a.Registrar = &AccountRegistrarService{service: c.Registrar, accountID: accountID}
+	a.Services = &AccountServicesService{service: c.Services, accountID: accountID}
+	a.Templates = &AccountTemplatesService{service: c.Templates, accountID: accountID}
+	a.VanityNameServers = &AccountVanityNameServersService{service: c.VanityNameServers, accountID: accountID}
+	a.Webhooks = &AccountWebhooksService{service: c.Webhooks, accountID: accountID}
+	a.Zones = &AccountZonesService{service: c.Zones, accountID: accountID}
+	return a
+}
+
+// ErrNoAccount is returned by Client.ForCurrentAccount when the account
+// can't be determined from the credentials.
+var ErrNoAccount = errors.New("dnsimple: unable to determine the account from the credentials")
+
+// currentAccount caches the account resolved by Client.ForCurrentAccount.
+type currentAccount struct {
+	mu        sync.Mutex
+	accountID string
+}
+
+// ForCurrentAccount returns a view of the client bound to the account of the credentials.
+//
+// The account is found once through Identity.Whoami, and cached for the following calls.
+// When the client authenticates with a user token, which is not scoped to an account,
+// the account is the only one the user has access to, according to Accounts.ListAccounts.
+// Otherwise, ForCurrentAccount returns ErrNoAccount.
+func (c *Client) ForCurrentAccount(ctx context.Context) (*AccountClient, error) {
+	c.current.mu.Lock()
+	defer c.current.mu.Unlock()
+
+	if c.current.accountID == "" {
+		accountID, err := c.resolveAccount(ctx)
+		if err != nil {
+			return nil, err
+		}
+		c.current.accountID = accountID
+	}
+	return c.ForAccount(c.current.accountID), nil
+}
+
+// resolveAccount returns the ID of the account of the credentials.
+func (c *Client) resolveAccount(ctx context.Context) (string, error) {
+	whoami, err := c.Identity.Whoami(ctx)
+	if err != nil {
+		return "", err
+	}
+	if account := whoami.Data.Account; account != nil {
+		return strconv.FormatInt(account.ID, 10), nil
+	}
+
+	accounts, err := c.Accounts.ListAccounts(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	if len(accounts.Data) != 1 {
+		return "", ErrNoAccount
+	}
+	return strconv.FormatInt(accounts.Data[0].ID, 10), nil
+}
+
+// AccountBillingService exposes the methods of BillingService bound to an account.
+type AccountBillingService struct {
+	service   *BillingService
+	accountID string
+}
+
+// ListCharges calls BillingService.ListCharges with the account ID.
+func (s *AccountBillingService) ListCharges(ctx context.Context, options ListChargesOptions) (*ListChargesResponse, error) {
+	return s.service.ListCharges(ctx, s.accountID, options)
+}
+
+// ListChargesIter calls BillingService.ListChargesIter with the account ID.
+func (s *AccountBillingService) ListChargesIter(ctx context.Context, options ListChargesOptions) iter.Seq2[Charge, error] {
+	return s.service.ListChargesIter(ctx, s.accountID, options)
+}
+
+// AccountCertificatesService exposes the methods of CertificatesService bound to an account.
+type AccountCertificatesService struct {
+	service   *CertificatesService
+	accountID string
+}
+
+// ListCertificates calls CertificatesService.ListCertificates with the account ID.
+func (s *AccountCertificatesService) ListCertificates(ctx context.Context, domainIdentifier string, options *ListOptions) (*CertificatesResponse, error) {
+	return s.service.ListCertificates(ctx, s.accountID, domainIdentifier, options)
+}
+
+// ListCertificatesIter calls CertificatesService.ListCertificatesIter with the account ID.
+func (s *AccountCertificatesService) ListCertificatesIter(ctx context.Context, domainIdentifier string, options *ListOptions) iter.Seq2[Certificate, error] {
+	return s.service.ListCertificatesIter(ctx, s.accountID, domainIdentifier, options)
+}
+
+// GetCertificate calls CertificatesService.GetCertificate with the account ID.
+func (s *AccountCertificatesService) GetCertificate(ctx context.Context, domainIdentifier string, certificateID int64) (*CertificateResponse, error) {
+	return s.service.GetCertificate(ctx, s.accountID, domainIdentifier, certificateID)
+}
+
+// DownloadCertificate calls CertificatesService.DownloadCertificate with the account ID.
+func (s *AccountCertificatesService) DownloadCertificate(ctx context.Context, domainIdentifier string, certificateID int64) (*CertificateBundleResponse, error) {
+	return s.service.DownloadCertificate(ctx, s.accountID, domainIdentifier, certificateID)
+}
+
+// GetCertificatePrivateKey calls CertificatesService.GetCertificatePrivateKey with the account ID.
+func (s *AccountCertificatesService) GetCertificatePrivateKey(ctx context.Context, domainIdentifier string, certificateID int64) (*CertificateBundleResponse, error) {
+	return s.service.GetCertificatePrivateKey(ctx, s.accountID, domainIdentifier, certificateID)
+}
+
+// PurchaseLetsencryptCertificate calls CertificatesService.PurchaseLetsencryptCertificate with the account ID.
+func (s *AccountCertificatesService) PurchaseLetsencryptCertificate(ctx context.Context, domainIdentifier string, certificateAttributes LetsencryptCertificateAttributes) (*CertificatePurchaseResponse, error) {
+	return s.service.PurchaseLetsencryptCertificate(ctx, s.accountID, domainIdentifier, certificateAttributes)
+}
+
+// IssueLetsencryptCertificate calls CertificatesService.IssueLetsencryptCertificate with the account ID.
+func (s *AccountCertificatesService) IssueLetsencryptCertificate(ctx context.Context, domainIdentifier string, certificateID int64) (*CertificateResponse, error) {
+	return s.service.IssueLetsencryptCertificate(ctx, s.accountID, domainIdentifier, certificateID)
+}
+
+// PurchaseLetsencryptCertificateRenewal calls CertificatesService.PurchaseLetsencryptCertificateRenewal with the account ID.
+func (s *AccountCertificatesService) PurchaseLetsencryptCertificateRenewal(ctx context.Context, domainIdentifier string, certificateID int64, certificateAttributes LetsencryptCertificateAttributes) (*CertificateRenewalResponse, error) {
+	return s.service.PurchaseLetsencryptCertificateRenewal(ctx, s.accountID, domainIdentifier, certificateID, certificateAttributes)
+}
+
+// IssueLetsencryptCertificateRenewal calls CertificatesService.IssueLetsencryptCertificateRenewal with the account ID.
+func (s *AccountCertificatesService) IssueLetsencryptCertificateRenewal(ctx context.Context, domainIdentifier string, certificateID int64, certificateRenewalID int64) (*CertificateResponse, error) {
+	return s.service.IssueLetsencryptCertificateRenewal(ctx, s.accountID, domainIdentifier, certificateID, certificateRenewalID)
+}
+
+// AccountContactsService exposes the methods of ContactsService bound to an account.
+type AccountContactsService struct {
+	service   *ContactsService
+	accountID string
+}
+
+// ListContacts calls ContactsService.ListContacts with the account ID.
+func (s *AccountContactsService) ListContacts(ctx context.Context, options *ListOptions) (*ContactsResponse, error) {
+	return s.service.ListContacts(ctx, s.accountID, options)
+}
+
+// ListContactsIter calls ContactsService.ListContactsIter with the account ID.
+func (s *AccountContactsService) ListContactsIter(ctx context.Context, options *ListOptions) iter.Seq2[Contact, error] {
+	return s.service.ListContactsIter(ctx, s.accountID, options)
+}
+
+// CreateContact calls ContactsService.CreateContact with the account ID.
+func (s *AccountContactsService) CreateContact(ctx context.Context, contactAttributes Contact) (*ContactResponse, error) {
+	return s.service.CreateContact(ctx, s.accountID, contactAttributes)
+}
+
+// GetContact calls ContactsService.GetContact with the account ID.
+func (s *AccountContactsService) GetContact(ctx context.Context, contactID int64) (*ContactResponse, error) {
+	return s.service.GetContact(ctx, s.accountID, contactID)
+}
+
+// UpdateContact calls ContactsService.UpdateContact with the account ID.
+func (s *AccountContactsService) UpdateContact(ctx context.Context, contactID int64, contactAttributes Contact) (*ContactResponse, error) {
+	return s.service.UpdateContact(ctx, s.accountID, contactID, contactAttributes)
+}
+
+// DeleteContact calls ContactsService.DeleteContact with the account ID.
+func (s *AccountContactsService) DeleteContact(ctx context.Context, contactID int64) (*ContactResponse, error) {
+	return s.service.DeleteContact(ctx, s.accountID, contactID)
+}
+
+// AccountDnsAnalyticsService exposes the methods of DnsAnalyticsService bound to an account.
+type AccountDnsAnalyticsService struct {
+	service   *DnsAnalyticsService
+	accountID string
+}
+
+// Query calls DnsAnalyticsService.Query with the account ID.
+func (s *AccountDnsAnalyticsService) Query(ctx context.Context, options *DnsAnalyticsOptions) (*DnsAnalyticsResponse, error) {
+	accountID, err := strconv.ParseInt(s.accountID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("dnsimple: invalid account ID %q", s.accountID)
+	}
+	return s.service.Query(ctx, accountID, options)
+}
+
+// AccountDomainsService exposes the methods of DomainsService bound to an account.
+type AccountDomainsService struct {
+	service   *DomainsService
+	accountID string
+}
+
+// ListDomains calls DomainsService.ListDomains with the account ID.
+func (s *AccountDomainsService) ListDomains(ctx context.Context, options *DomainListOptions) (*DomainsResponse, error) {
+	return s.service.ListDomains(ctx, s.accountID, options)
+}
+
+// ListDomainsIter calls DomainsService.ListDomainsIter with the account ID.
+func (s *AccountDomainsService) ListDomainsIter(ctx context.Context, options *DomainListOptions) iter.Seq2[Domain, error] {
+	return s.service.ListDomainsIter(ctx, s.accountID, options)
+}
+
+// CreateDomain calls DomainsService.CreateDomain with the account ID.
+func (s *AccountDomainsService) CreateDomain(ctx context.Context, domainAttributes Domain) (*DomainResponse, error) {
+	return s.service.CreateDomain(ctx, s.accountID, domainAttributes)
+}
+
+// GetDomain calls DomainsService.GetDomain with the account ID.
+func (s *AccountDomainsService) GetDomain(ctx context.Context, domainIdentifier string) (*DomainResponse, error) {
+	return s.service.GetDomain(ctx, s.accountID, domainIdentifier)
+}
+
+// DeleteDomain calls DomainsService.DeleteDomain with the account ID.
+func (s *AccountDomainsService) DeleteDomain(ctx context.Context, domainIdentifier string) (*DomainResponse, error) {
+	return s.service.DeleteDomain(ctx, s.accountID, domainIdentifier)
+}
+
+// GetDomainResearchStatus calls DomainsService.GetDomainResearchStatus with the account ID.
+func (s *AccountDomainsService) GetDomainResearchStatus(ctx context.Context, domainName string) (*DomainResearchStatusResponse, error) {
+	return s.service.GetDomainResearchStatus(ctx, s.accountID, domainName)
+}
+
+// ListDelegationSignerRecords calls DomainsService.ListDelegationSignerRecords with the account ID.
+func (s *AccountDomainsService) ListDelegationSignerRecords(ctx context.Context, domainIdentifier string, options *ListOptions) (*DelegationSignerRecordsResponse, error) {
+	return s.service.ListDelegationSignerRecords(ctx, s.accountID, domainIdentifier, options)
+}
+
+// ListDelegationSignerRecordsIter calls DomainsService.ListDelegationSignerRecordsIter with the account ID.
+func (s *AccountDomainsService) ListDelegationSignerRecordsIter(ctx context.Context, domainIdentifier string, options *ListOptions) iter.Seq2[DelegationSignerRecord, error] {
+	return s.service.ListDelegationSignerRecordsIter(ctx, s.accountID, domainIdentifier, options)
+}
+
+// CreateDelegationSignerRecord calls DomainsService.CreateDelegationSignerRecord with the account ID.
+func (s *AccountDomainsService) CreateDelegationSignerRecord(ctx context.Context, domainIdentifier string, dsRecordAttributes DelegationSignerRecord) (*DelegationSignerRecordResponse, error) {
+	return s.service.CreateDelegationSignerRecord(ctx, s.accountID, domainIdentifier, dsRecordAttributes)
+}
+
+// GetDelegationSignerRecord calls DomainsService.GetDelegationSignerRecord with the account ID.
+func (s *AccountDomainsService) GetDelegationSignerRecord(ctx context.Context, domainIdentifier string, dsRecordID int64) (*DelegationSignerRecordResponse, error) {
+	return s.service.GetDelegationSignerRecord(ctx, s.accountID, domainIdentifier, dsRecordID)
+}
+
+// DeleteDelegationSignerRecord calls DomainsService.DeleteDelegationSignerRecord with the account ID.
+func (s *AccountDomainsService) DeleteDelegationSignerRecord(ctx context.Context, domainIdentifier string, dsRecordID int64) (*DelegationSignerRecordResponse, error) {
+	return s.service.DeleteDelegationSignerRecord(ctx, s.accountID, domainIdentifier, dsRecordID)
+}
+
+// EnableDnssec calls DomainsService.EnableDnssec with the account ID.
+func (s *AccountDomainsService) EnableDnssec(ctx context.Context, domainIdentifier string) (*DnssecResponse, error) {
+	return s.service.EnableDnssec(ctx, s.accountID, domainIdentifier)
+}
+
+// DisableDnssec calls DomainsService.DisableDnssec with the account ID.
+func (s *AccountDomainsService) DisableDnssec(ctx context.Context, domainIdentifier string) (*DnssecResponse, error) {
+	return s.service.DisableDnssec(ctx, s.accountID, domainIdentifier)
+}
+
+// GetDnssec calls DomainsService.GetDnssec with the account ID.
+func (s *AccountDomainsService) GetDnssec(ctx context.Context, domainIdentifier string) (*DnssecResponse, error) {
+	return s.service.GetDnssec(ctx, s.accountID, domainIdentifier)
+}
+
+// ListEmailForwards calls DomainsService.ListEmailForwards with the account ID.
+func (s *AccountDomainsService) ListEmailForwards(ctx context.Context, domainIdentifier string, options *ListOptions) (*EmailForwardsResponse, error) {
+	return s.service.ListEmailForwards(ctx, s.accountID, domainIdentifier, options)
+}
+
+// ListEmailForwardsIter calls DomainsService.ListEmailForwardsIter with the account ID.
+func (s *AccountDomainsService) ListEmailForwardsIter(ctx context.Context, domainIdentifier string, options *ListOptions) iter.Seq2[EmailForward, error] {
+	return s.service.ListEmailForwardsIter(ctx, s.accountID, domainIdentifier, options)
+}
+
+// CreateEmailForward calls DomainsService.CreateEmailForward with the account ID.
+func (s *AccountDomainsService) CreateEmailForward(ctx context.Context, domainIdentifier string, forwardAttributes EmailForward) (*EmailForwardResponse, error) {
+	return s.service.CreateEmailForward(ctx, s.accountID, domainIdentifier, forwardAttributes)
+}
+
+// GetEmailForward calls DomainsService.GetEmailForward with the account ID.
+func (s *AccountDomainsService) GetEmailForward(ctx context.Context, domainIdentifier string, forwardID int64) (*EmailForwardResponse, error) {
+	return s.service.GetEmailForward(ctx, s.accountID, domainIdentifier, forwardID)
+}
+
+// DeleteEmailForward calls DomainsService.DeleteEmailForward with the account ID.
+func (s *AccountDomainsService) DeleteEmailForward(ctx context.Context, domainIdentifier string, forwardID int64) (*EmailForwardResponse, error) {
+	return s.service.DeleteEmailForward(ctx, s.accountID, domainIdentifier, forwardID)
+}
+
+// InitiatePush calls DomainsService.InitiatePush with the account ID.
+func (s *AccountDomainsService) InitiatePush(ctx context.Context, domainID string, pushAttributes DomainPushAttributes) (*DomainPushResponse, error) {
+	return s.service.InitiatePush(ctx, s.accountID, domainID, pushAttributes)
+}
+
+// ListPushes calls DomainsService.ListPushes with the account ID.
+func (s *AccountDomainsService) ListPushes(ctx context.Context, options *ListOptions) (*DomainPushesResponse, error) {
+	return s.service.ListPushes(ctx, s.accountID, options)
+}
+
+// ListPushesIter calls DomainsService.ListPushesIter with the account ID.
+func (s *AccountDomainsService) ListPushesIter(ctx context.Context, options *ListOptions) iter.Seq2[DomainPush, error] {
+	return s.service.ListPushesIter(ctx, s.accountID, options)
+}
+
+// AcceptPush calls DomainsService.AcceptPush with the account ID.
+func (s *AccountDomainsService) AcceptPush(ctx context.Context, pushID int64, pushAttributes DomainPushAttributes) (*DomainPushResponse, error) {
+	return s.service.AcceptPush(ctx, s.accountID, pushID, pushAttributes)
+}
+
+// RejectPush calls DomainsService.RejectPush with the account ID.
+func (s *AccountDomainsService) RejectPush(ctx context.Context, pushID int64) (*DomainPushResponse, error) {
+	return s.service.RejectPush(ctx, s.accountID, pushID)
+}
+
+// AccountRegistrarService exposes the methods of RegistrarService bound to an account.
+type AccountRegistrarService struct {
+	service   *RegistrarService
+	accountID string
+}
+
+// CheckDomain calls RegistrarService.CheckDomain with the account ID.
+func (s *AccountRegistrarService) CheckDomain(ctx context.Context, domainName string) (*DomainCheckResponse, error) {
+	return s.service.CheckDomain(ctx, s.accountID, domainName)
+}
+
+// GetDomainPrices calls RegistrarService.GetDomainPrices with the account ID.
+func (s *AccountRegistrarService) GetDomainPrices(ctx context.Context, domainName string) (*DomainPriceResponse, error) {
+	return s.service.GetDomainPrices(ctx, s.accountID, domainName)
+}
+
+// GetDomainRegistration calls RegistrarService.GetDomainRegistration with the account ID.
+func (s *AccountRegistrarService) GetDomainRegistration(ctx context.Context, domainName string, domainRegistrationID string) (*DomainRegistrationResponse, error) {
+	return s.service.GetDomainRegistration(ctx, s.accountID, domainName, domainRegistrationID)
+}
+
+// RegisterDomain calls RegistrarService.RegisterDomain with the account ID.
+func (s *AccountRegistrarService) RegisterDomain(ctx context.Context, domainName string, input *RegisterDomainInput) (*DomainRegistrationResponse, error) {
+	return s.service.RegisterDomain(ctx, s.accountID, domainName, input)
+}
+
+// TransferDomain calls RegistrarService.TransferDomain with the account ID.
+func (s *AccountRegistrarService) TransferDomain(ctx context.Context, domainName string, input *TransferDomainInput) (*DomainTransferResponse, error) {
+	return s.service.TransferDomain(ctx, s.accountID, domainName, input)
+}
+
+// GetDomainTransfer calls RegistrarService.GetDomainTransfer with the account ID.
+func (s *AccountRegistrarService) GetDomainTransfer(ctx context.Context, domainName string, domainTransferID int64) (*DomainTransferResponse, error) {
+	return s.service.GetDomainTransfer(ctx, s.accountID, domainName, domainTransferID)
+}
+
+// CancelDomainTransfer calls RegistrarService.CancelDomainTransfer with the account ID.
+func (s *AccountRegistrarService) CancelDomainTransfer(ctx context.Context, domainName string, domainTransferID int64) (*DomainTransferResponse, error) {
+	return s.service.CancelDomainTransfer(ctx, s.accountID, domainName, domainTransferID)
+}
+
+// TransferDomainOut calls RegistrarService.TransferDomainOut with the account ID.
+func (s *AccountRegistrarService) TransferDomainOut(ctx context.Context, domainName string) (*DomainTransferOutResponse, error) {
+	return s.service.TransferDomainOut(ctx, s.accountID, domainName)
+}
+
+// GetDomainRenewal calls RegistrarService.GetDomainRenewal with the account ID.
+func (s *AccountRegistrarService) GetDomainRenewal(ctx context.Context, domainName string, domainRenewalID string) (*DomainRenewalResponse, error) {
+	return s.service.GetDomainRenewal(ctx, s.accountID, domainName, domainRenewalID)
+}
+
+// RenewDomain calls RegistrarService.RenewDomain with the account ID.
+func (s *AccountRegistrarService) RenewDomain(ctx context.Context, domainName string, input *RenewDomainInput) (*DomainRenewalResponse, error) {
+	return s.service.RenewDomain(ctx, s.accountID, domainName, input)
+}
+
+// RestoreDomain calls RegistrarService.RestoreDomain with the account ID.
+func (s *AccountRegistrarService) RestoreDomain(ctx context.Context, domainName string, input *RenewDomainInput) (*DomainRenewalResponse, error) {
+	return s.service.RestoreDomain(ctx, s.accountID, domainName, input)
+}
+
+// GetDomainRestore calls RegistrarService.GetDomainRestore with the account ID.
+func (s *AccountRegistrarService) GetDomainRestore(ctx context.Context, domainName string, domainRestoreID string) (*DomainRestoreResponse, error) {
+	return s.service.GetDomainRestore(ctx, s.accountID, domainName, domainRestoreID)
+}
+
+// EnableDomainAutoRenewal calls RegistrarService.EnableDomainAutoRenewal with the account ID.
+func (s *AccountRegistrarService) EnableDomainAutoRenewal(ctx context.Context, domainName string) (*DomainResponse, error) {
+	return s.service.EnableDomainAutoRenewal(ctx, s.accountID, domainName)
+}
+
+// DisableDomainAutoRenewal calls RegistrarService.DisableDomainAutoRenewal with the account ID.
+func (s *AccountRegistrarService) DisableDomainAutoRenewal(ctx context.Context, domainName string) (*DomainResponse, error) {
+	return s.service.DisableDomainAutoRenewal(ctx, s.accountID, domainName)
+}
+
+// GetDomainDelegation calls RegistrarService.GetDomainDelegation with the account ID.
+func (s *AccountRegistrarService) GetDomainDelegation(ctx context.Context, domainName string) (*DelegationResponse, error) {
+	return s.service.GetDomainDelegation(ctx, s.accountID, domainName)
+}
+
+// ChangeDomainDelegation calls RegistrarService.ChangeDomainDelegation with the account ID.
+func (s *AccountRegistrarService) ChangeDomainDelegation(ctx context.Context, domainName string, newDelegation *Delegation) (*DelegationResponse, error) {
+	return s.service.ChangeDomainDelegation(ctx, s.accountID, domainName, newDelegation)
+}
+
+// ChangeDomainDelegationToVanity calls RegistrarService.ChangeDomainDelegationToVanity with the account ID.
+func (s *AccountRegistrarService) ChangeDomainDelegationToVanity(ctx context.Context, domainName string, newDelegation *Delegation) (*VanityDelegationResponse, error) {
+	return s.service.ChangeDomainDelegationToVanity(ctx, s.accountID, domainName, newDelegation)
+}
+
+// ChangeDomainDelegationFromVanity calls RegistrarService.ChangeDomainDelegationFromVanity with the account ID.
+func (s *AccountRegistrarService) ChangeDomainDelegationFromVanity(ctx context.Context, domainName string) (*VanityDelegationResponse, error) {
+	return s.service.ChangeDomainDelegationFromVanity(ctx, s.accountID, domainName)
+}
+
+// GetDomainTransferLock calls RegistrarService.GetDomainTransferLock with the account ID.
+func (s *AccountRegistrarService) GetDomainTransferLock(ctx context.Context, domainIdentifier string) (*DomainTransferLockResponse, error) {
+	return s.service.GetDomainTransferLock(ctx, s.accountID, domainIdentifier)
+}
+
+// EnableDomainTransferLock calls RegistrarService.EnableDomainTransferLock with the account ID.
+func (s *AccountRegistrarService) EnableDomainTransferLock(ctx context.Context, domainIdentifier string) (*DomainTransferLockResponse, error) {
+	return s.service.EnableDomainTransferLock(ctx, s.accountID, domainIdentifier)
+}
+
+// DisableDomainTransferLock calls RegistrarService.DisableDomainTransferLock with the account ID.
+func (s *AccountRegistrarService) DisableDomainTransferLock(ctx context.Context, domainIdentifier string) (*DomainTransferLockResponse, error) {
+	return s.service.DisableDomainTransferLock(ctx, s.accountID, domainIdentifier)
+}
+
+// ListRegistrantChange calls RegistrarService.ListRegistrantChange with the account ID.
+func (s *AccountRegistrarService) ListRegistrantChange(ctx context.Context, options *RegistrantChangeListOptions) (*RegistrantChangesListResponse, error) {
+	return s.service.ListRegistrantChange(ctx, s.accountID, options)
+}
+
+// ListRegistrantChangeIter calls RegistrarService.ListRegistrantChangeIter with the account ID.
+func (s *AccountRegistrarService) ListRegistrantChangeIter(ctx context.Context, options *RegistrantChangeListOptions) iter.Seq2[RegistrantChange, error] {
+	return s.service.ListRegistrantChangeIter(ctx, s.accountID, options)
+}
+
+// CreateRegistrantChange calls RegistrarService.CreateRegistrantChange with the account ID.
+func (s *AccountRegistrarService) CreateRegistrantChange(ctx context.Context, input *CreateRegistrantChangeInput) (*RegistrantChangeResponse, error) {
+	return s.service.CreateRegistrantChange(ctx, s.accountID, input)
+}
+
+// CheckRegistrantChange calls RegistrarService.CheckRegistrantChange with the account ID.
+func (s *AccountRegistrarService) CheckRegistrantChange(ctx context.Context, input *CheckRegistrantChangeInput) (*RegistrantChangeCheckResponse, error) {
+	return s.service.CheckRegistrantChange(ctx, s.accountID, input)
+}
+
+// GetRegistrantChange calls RegistrarService.GetRegistrantChange with the account ID.
+func (s *AccountRegistrarService) GetRegistrantChange(ctx context.Context, registrantChange int) (*RegistrantChangeResponse, error) {
+	return s.service.GetRegistrantChange(ctx, s.accountID, registrantChange)
+}
+
+// DeleteRegistrantChange calls RegistrarService.DeleteRegistrantChange with the account ID.
+func (s *AccountRegistrarService) DeleteRegistrantChange(ctx context.Context, registrantChange int) (*RegistrantChangeDeleteResponse, error) {
+	return s.service.DeleteRegistrantChange(ctx, s.accountID, registrantChange)
+}
+
+// EnableWhoisPrivacy calls RegistrarService.EnableWhoisPrivacy with the account ID.
+func (s *AccountRegistrarService) EnableWhoisPrivacy(ctx context.Context, domainName string) (*WhoisPrivacyResponse, error) {
+	return s.service.EnableWhoisPrivacy(ctx, s.accountID, domainName)
+}
+
+// DisableWhoisPrivacy calls RegistrarService.DisableWhoisPrivacy with the account ID.
+func (s *AccountRegistrarService) DisableWhoisPrivacy(ctx context.Context, domainName string) (*WhoisPrivacyResponse, error) {
+	return s.service.DisableWhoisPrivacy(ctx, s.accountID, domainName)
+}
+
+// AccountServicesService exposes the methods of ServicesService bound to an account.
+type AccountServicesService struct {
+	service   *ServicesService
+	accountID string
+}
+
+// AppliedServices calls ServicesService.AppliedServices with the account ID.
+func (s *AccountServicesService) AppliedServices(ctx context.Context, domainIdentifier string, options *ListOptions) (*ServicesResponse, error) {
+	return s.service.AppliedServices(ctx, s.accountID, domainIdentifier, options)
+}
+
+// AppliedServicesIter calls ServicesService.AppliedServicesIter with the account ID.
+func (s *AccountServicesService) AppliedServicesIter(ctx context.Context, domainIdentifier string, options *ListOptions) iter.Seq2[Service, error] {
+	return s.service.AppliedServicesIter(ctx, s.accountID, domainIdentifier, options)
+}
+
+// ApplyService calls ServicesService.ApplyService with the account ID.
+func (s *AccountServicesService) ApplyService(ctx context.Context, serviceIdentifier string, domainIdentifier string, settings DomainServiceSettings) (*ServiceResponse, error) {
+	return s.service.ApplyService(ctx, s.accountID, serviceIdentifier, domainIdentifier, settings)
+}
+
+// UnapplyService calls ServicesService.UnapplyService with the account ID.
+func (s *AccountServicesService) UnapplyService(ctx context.Context, serviceIdentifier string, domainIdentifier string) (*ServiceResponse, error) {
+	return s.service.UnapplyService(ctx, s.accountID, serviceIdentifier, domainIdentifier)
+}
+
+// AccountTemplatesService exposes the methods of TemplatesService bound to an account.
+type AccountTemplatesService struct {
+	service   *TemplatesService
+	accountID string
+}
+
+// ListTemplates calls TemplatesService.ListTemplates with the account ID.
+func (s *AccountTemplatesService) ListTemplates(ctx context.Context, options *ListOptions) (*TemplatesResponse, error) {
+	return s.service.ListTemplates(ctx, s.accountID, options)
+}
+
+// ListTemplatesIter calls TemplatesService.ListTemplatesIter with the account ID.
+func (s *AccountTemplatesService) ListTemplatesIter(ctx context.Context, options *ListOptions) iter.Seq2[Template, error] {
+	return s.service.ListTemplatesIter(ctx, s.accountID, options)
+}
+
+// CreateTemplate calls TemplatesService.CreateTemplate with the account ID.
+func (s *AccountTemplatesService) CreateTemplate(ctx context.Context, templateAttributes Template) (*TemplateResponse, error) {
+	return s.service.CreateTemplate(ctx, s.accountID, templateAttributes)
+}
+
+// GetTemplate calls TemplatesService.GetTemplate with the account ID.
+func (s *AccountTemplatesService) GetTemplate(ctx context.Context, templateIdentifier string) (*TemplateResponse, error) {
+	return s.service.GetTemplate(ctx, s.accountID, templateIdentifier)
+}
+
+// UpdateTemplate calls TemplatesService.UpdateTemplate with the account ID.
+func (s *AccountTemplatesService) UpdateTemplate(ctx context.Context, templateIdentifier string, templateAttributes Template) (*TemplateResponse, error) {
+	return s.service.UpdateTemplate(ctx, s.accountID, templateIdentifier, templateAttributes)
+}
+
+// DeleteTemplate calls TemplatesService.DeleteTemplate with the account ID.
+func (s *AccountTemplatesService) DeleteTemplate(ctx context.Context, templateIdentifier string) (*TemplateResponse, error) {
+	return s.service.DeleteTemplate(ctx, s.accountID, templateIdentifier)
+}
+
+// ApplyTemplate calls TemplatesService.ApplyTemplate with the account ID.
+func (s *AccountTemplatesService) ApplyTemplate(ctx context.Context, templateIdentifier string, domainIdentifier string) (*TemplateResponse, error) {
+	return s.service.ApplyTemplate(ctx, s.accountID, templateIdentifier, domainIdentifier)
+}
+
+// ListTemplateRecords calls TemplatesService.ListTemplateRecords with the account ID.
+func (s *AccountTemplatesService) ListTemplateRecords(ctx context.Context, templateIdentifier string, options *ListOptions) (*TemplateRecordsResponse, error) {
+	return s.service.ListTemplateRecords(ctx, s.accountID, templateIdentifier, options)
+}
+
+// ListTemplateRecordsIter calls TemplatesService.ListTemplateRecordsIter with the account ID.
+func (s *AccountTemplatesService) ListTemplateRecordsIter(ctx context.Context, templateIdentifier string, options *ListOptions) iter.Seq2[TemplateRecord, error] {
+	return s.service.ListTemplateRecordsIter(ctx, s.accountID, templateIdentifier, options)
+}
+
+// CreateTemplateRecord calls TemplatesService.CreateTemplateRecord with the account ID.
+func (s *AccountTemplatesService) CreateTemplateRecord(ctx context.Context, templateIdentifier string, templateRecordAttributes TemplateRecord) (*TemplateRecordResponse, error) {
+	return s.service.CreateTemplateRecord(ctx, s.accountID, templateIdentifier, templateRecordAttributes)
+}
+
+// GetTemplateRecord calls TemplatesService.GetTemplateRecord with the account ID.
+func (s *AccountTemplatesService) GetTemplateRecord(ctx context.Context, templateIdentifier string, templateRecordID int64) (*TemplateRecordResponse, error) {
+	return s.service.GetTemplateRecord(ctx, s.accountID, templateIdentifier, templateRecordID)
+}
+
+// DeleteTemplateRecord calls TemplatesService.DeleteTemplateRecord with the account ID.
+func (s *AccountTemplatesService) DeleteTemplateRecord(ctx context.Context, templateIdentifier string, templateRecordID int64) (*TemplateRecordResponse, error) {
+	return s.service.DeleteTemplateRecord(ctx, s.accountID, templateIdentifier, templateRecordID)
+}
+
+// AccountVanityNameServersService exposes the methods of VanityNameServersService bound to an account.
+type AccountVanityNameServersService struct {
+	service   *VanityNameServersService
+	accountID string
+}
+
+// EnableVanityNameServers calls VanityNameServersService.EnableVanityNameServers with the account ID.
+func (s *AccountVanityNameServersService) EnableVanityNameServers(ctx context.Context, domainIdentifier string) (*VanityNameServerResponse, error) {
+	return s.service.EnableVanityNameServers(ctx, s.accountID, domainIdentifier)
+}
+
+// DisableVanityNameServers calls VanityNameServersService.DisableVanityNameServers with the account ID.
+func (s *AccountVanityNameServersService) DisableVanityNameServers(ctx context.Context, domainIdentifier string) (*VanityNameServerResponse, error) {
+	return s.service.DisableVanityNameServers(ctx, s.accountID, domainIdentifier)
+}
+
+// AccountWebhooksService exposes the methods of WebhooksService bound to an account.
+type AccountWebhooksService struct {
+	service   *WebhooksService
+	accountID string
+}
+
+// ListWebhooks calls WebhooksService.ListWebhooks with the account ID.
+func (s *AccountWebhooksService) ListWebhooks(ctx context.Context, options *ListOptions) (*WebhooksResponse, error) {
+	return s.service.ListWebhooks(ctx, s.accountID, options)
+}
+
+// ListWebhooksIter calls WebhooksService.ListWebhooksIter with the account ID.
+func (s *AccountWebhooksService) ListWebhooksIter(ctx context.Context, options *ListOptions) iter.Seq2[Webhook, error] {
+	return s.service.ListWebhooksIter(ctx, s.accountID, options)
+}
+
+// CreateWebhook calls WebhooksService.CreateWebhook with the account ID.
+func (s *AccountWebhooksService) CreateWebhook(ctx context.Context, webhookAttributes Webhook) (*WebhookResponse, error) {
+	return s.service.CreateWebhook(ctx, s.accountID, webhookAttributes)
+}
+
+// GetWebhook calls WebhooksService.GetWebhook with the account ID.
+func (s *AccountWebhooksService) GetWebhook(ctx context.Context, webhookID int64) (*WebhookResponse, error) {
+	return s.service.GetWebhook(ctx, s.accountID, webhookID)
+}
+
+// DeleteWebhook calls WebhooksService.DeleteWebhook with the account ID.
+func (s *AccountWebhooksService) DeleteWebhook(ctx context.Context, webhookID int64) (*WebhookResponse, error) {
+	return s.service.DeleteWebhook(ctx, s.accountID, webhookID)
+}
+
+// AccountZonesService exposes the methods of ZonesService bound to an account.
+type AccountZonesService struct {
+	service   *ZonesService
+	accountID string
+}
+
+// CheckZoneDistribution calls ZonesService.CheckZoneDistribution with the account ID.
+func (s *AccountZonesService) CheckZoneDistribution(ctx context.Context, zoneName string) (*ZoneDistributionResponse, error) {
+	return s.service.CheckZoneDistribution(ctx, s.accountID, zoneName)
+}
+
+// CheckZoneRecordDistribution calls ZonesService.CheckZoneRecordDistribution with the account ID.
+func (s *AccountZonesService) CheckZoneRecordDistribution(ctx context.Context, zoneName string, recordID int64) (*ZoneDistributionResponse, error) {
+	return s.service.CheckZoneRecordDistribution(ctx, s.accountID, zoneName, recordID)
+}
+
+// ListZones calls ZonesService.ListZones with the account ID.
+func (s *AccountZonesService) ListZones(ctx context.Context, options *ZoneListOptions) (*ZonesResponse, error) {
+	return s.service.ListZones(ctx, s.accountID, options)
+}
+
+// ListZonesIter calls ZonesService.ListZonesIter with the account ID.
+func (s *AccountZonesService) ListZonesIter(ctx context.Context, options *ZoneListOptions) iter.Seq2[Zone, error] {
+	return s.service.ListZonesIter(ctx, s.accountID, options)
+}
+
+// GetZone calls ZonesService.GetZone with the account ID.
+func (s *AccountZonesService) GetZone(ctx context.Context, zoneName string) (*ZoneResponse, error) {
+	return s.service.GetZone(ctx, s.accountID, zoneName)
+}
+
+// GetZoneFile calls ZonesService.GetZoneFile with the account ID.
+func (s *AccountZonesService) GetZoneFile(ctx context.Context, zoneName string) (*ZoneFileResponse, error) {
+	return s.service.GetZoneFile(ctx, s.accountID, zoneName)
+}
+
+// ActivateZoneDns calls ZonesService.ActivateZoneDns with the account ID.
+func (s *AccountZonesService) ActivateZoneDns(ctx context.Context, zoneName string) (*ZoneResponse, error) {
+	return s.service.ActivateZoneDns(ctx, s.accountID, zoneName)
+}
+
+// DeactivateZoneDns calls ZonesService.DeactivateZoneDns with the account ID.
+func (s *AccountZonesService) DeactivateZoneDns(ctx context.Context, zoneName string) (*ZoneResponse, error) {
+	return s.service.DeactivateZoneDns(ctx, s.accountID, zoneName)
+}
+
+// ListRecords calls ZonesService.ListRecords with the account ID.
+func (s *AccountZonesService) ListRecords(ctx context.Context, zoneName string, options *ZoneRecordListOptions) (*ZoneRecordsResponse, error) {
+	return s.service.ListRecords(ctx, s.accountID, zoneName, options)
+}
+
+// ListRecordsIter calls ZonesService.ListRecordsIter with the account ID.
+func (s *AccountZonesService) ListRecordsIter(ctx context.Context, zoneName string, options *ZoneRecordListOptions) iter.Seq2[ZoneRecord, error] {
+	return s.service.ListRecordsIter(ctx, s.accountID, zoneName, options)
+}
+
+// CreateRecord calls ZonesService.CreateRecord with the account ID.
+func (s *AccountZonesService) CreateRecord(ctx context.Context, zoneName string, recordAttributes ZoneRecordAttributes) (*ZoneRecordResponse, error) {
+	return s.service.CreateRecord(ctx, s.accountID, zoneName, recordAttributes)
+}
+
+// GetRecord calls ZonesService.GetRecord with the account ID.
+func (s *AccountZonesService) GetRecord(ctx context.Context, zoneName string, recordID int64) (*ZoneRecordResponse, error) {
+	return s.service.GetRecord(ctx, s.accountID, zoneName, recordID)
+}
+
+// UpdateRecord calls ZonesService.UpdateRecord with the account ID.
+func (s *AccountZonesService) UpdateRecord(ctx context.Context, zoneName string, recordID int64, recordAttributes ZoneRecordAttributes) (*ZoneRecordResponse, error) {
+	return s.service.UpdateRecord(ctx, s.accountID, zoneName, recordID, recordAttributes)
+}
+
+// DeleteRecord calls ZonesService.DeleteRecord with the account ID.
+func (s *AccountZonesService) DeleteRecord(ctx context.Context, zoneName string, recordID int64) (*ZoneRecordResponse, error) {
+	return s.service.DeleteRecord(ctx, s.accountID, zoneName, recordID)
+}
+
+// BatchChangeZoneRecords calls ZonesService.BatchChangeZoneRecords with the account ID.
+func (s *AccountZonesService) BatchChangeZoneRecords(ctx context.Context, zoneName string, request BatchChangeZoneRecordsRequest) (*BatchChangeZoneRecordsResponse, error) {
+	return s.service.BatchChangeZoneRecords(ctx, s.accountID, zoneName, request)
+}
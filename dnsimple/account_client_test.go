@@ -0,0 +1,126 @@
+package dnsimple
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ForAccount(t *testing.T) {
+	setupMockServer()
+	defer teardownMockServer()
+
+	mux.HandleFunc("/v2/1010/zones/example.com/records", func(w http.ResponseWriter, r *http.Request) {
+		httpResponse := httpResponseFixture(t, "/api/listZoneRecords/success.http")
+
+		testMethod(t, r, "GET")
+		testHeaders(t, r)
+
+		w.WriteHeader(httpResponse.StatusCode)
+		_, _ = io.Copy(w, httpResponse.Body)
+	})
+
+	account := client.ForAccount("1010")
+	assert.Same(t, client, account.Client)
+	assert.Equal(t, "1010", account.AccountID)
+
+	recordsResponse, err := account.Zones.ListRecords(context.Background(), "example.com", nil)
+
+	assert.NoError(t, err)
+	assert.Len(t, recordsResponse.Data, 5)
+}
+
+func TestClient_ForAccount_DnsAnalytics(t *testing.T) {
+	setupMockServer()
+	defer teardownMockServer()
+
+	mux.HandleFunc("/v2/1/dns_analytics", func(w http.ResponseWriter, r *http.Request) {
+		httpResponse := httpResponseFixture(t, "/api/dnsAnalytics/success.http")
+
+		testMethod(t, r, "GET")
+
+		w.WriteHeader(httpResponse.StatusCode)
+		_, _ = io.Copy(w, httpResponse.Body)
+	})
+
+	dnsAnalyticsResponse, err := client.ForAccount("1").DnsAnalytics.Query(context.Background(), nil)
+
+	assert.NoError(t, err)
+	assert.Len(t, dnsAnalyticsResponse.Data, 12)
+
+	_, err = client.ForAccount("_").DnsAnalytics.Query(context.Background(), nil)
+
+	assert.EqualError(t, err, `dnsimple: invalid account ID "_"`)
+}
+
+func TestClient_ForCurrentAccount(t *testing.T) {
+	setupMockServer()
+	defer teardownMockServer()
+
+	calls := 0
+	mux.HandleFunc("/v2/whoami", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		httpResponse := httpResponseFixture(t, "/api/whoami/success-account.http")
+
+		w.WriteHeader(httpResponse.StatusCode)
+		_, _ = io.Copy(w, httpResponse.Body)
+	})
+
+	account, err := client.ForCurrentAccount(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "1", account.AccountID)
+
+	account, err = client.ForCurrentAccount(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "1", account.AccountID)
+	assert.Equal(t, 1, calls)
+}
+
+func TestClient_ForCurrentAccount_UserToken(t *testing.T) {
+	setupMockServer()
+	defer teardownMockServer()
+
+	mux.HandleFunc("/v2/whoami", func(w http.ResponseWriter, r *http.Request) {
+		httpResponse := httpResponseFixture(t, "/api/whoami/success-user.http")
+
+		w.WriteHeader(httpResponse.StatusCode)
+		_, _ = io.Copy(w, httpResponse.Body)
+	})
+	mux.HandleFunc("/v2/accounts", func(w http.ResponseWriter, r *http.Request) {
+		httpResponse := httpResponseFixture(t, "/api/listAccounts/success-account.http")
+
+		w.WriteHeader(httpResponse.StatusCode)
+		_, _ = io.Copy(w, httpResponse.Body)
+	})
+
+	account, err := client.ForCurrentAccount(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, "123", account.AccountID)
+}
+
+func TestClient_ForCurrentAccount_MultipleAccounts(t *testing.T) {
+	setupMockServer()
+	defer teardownMockServer()
+
+	mux.HandleFunc("/v2/whoami", func(w http.ResponseWriter, r *http.Request) {
+		httpResponse := httpResponseFixture(t, "/api/whoami/success-user.http")
+
+		w.WriteHeader(httpResponse.StatusCode)
+		_, _ = io.Copy(w, httpResponse.Body)
+	})
+	mux.HandleFunc("/v2/accounts", func(w http.ResponseWriter, r *http.Request) {
+		httpResponse := httpResponseFixture(t, "/api/listAccounts/success-user.http")
+
+		w.WriteHeader(httpResponse.StatusCode)
+		_, _ = io.Copy(w, httpResponse.Body)
+	})
+
+	_, err := client.ForCurrentAccount(context.Background())
+
+	assert.ErrorIs(t, err, ErrNoAccount)
+}
@@ -2,6 +2,7 @@ package dnsimple
 
 import (
 	"context"
+	"iter"
 )
 
 // AccountsService handles communication with the account related
@@ -66,7 +67,7 @@ func (s *AccountsService) ListAccounts(ctx context.Context, options *ListOptions
 		return nil, err
 	}
 
-	resp, err := s.client.get(ctx, path, accountsResponse)
+	resp, err := s.client.get(ctx, "Accounts.ListAccounts", path, accountsResponse)
 	if err != nil {
 		return accountsResponse, err
 	}
@@ -74,3 +75,25 @@ func (s *AccountsService) ListAccounts(ctx context.Context, options *ListOptions
 	accountsResponse.HTTPResponse = resp
 	return accountsResponse, nil
 }
+
+// ListAccountsIter returns an iterator over the accounts the current authenticated entity has access to,
+// that fetches the pages lazily as the iteration progresses.
+//
+// See ListAccounts for the available options, and All to collect the results.
+func (s *AccountsService) ListAccountsIter(ctx context.Context, options *ListOptions) iter.Seq2[Account, error] {
+	opts := ListOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	return paginate(ctx, s.client, firstPage(&opts), func(ctx context.Context, page int) ([]Account, *Response, error) {
+		opts := opts
+		opts.Page = &page
+
+		resp, err := s.ListAccounts(ctx, &opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Data, &resp.Response, nil
+	})
+}
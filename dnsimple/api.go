@@ -0,0 +1,234 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package dnsimple
+
+import (
+	"context"
+	"iter"
+	"net/url"
+
+	"golang.org/x/oauth2"
+)
+
+//go:generate go run ./internal/apigen
+
+// IdentityAPI is the interface of IdentityService, to depend on in place of the concrete service.
+// See the dnsimplemock package for a fake implementation.
+type IdentityAPI interface {
+	Whoami(ctx context.Context) (*WhoamiResponse, error)
+}
+
+// AccountsAPI is the interface of AccountsService, to depend on in place of the concrete service.
+// See the dnsimplemock package for a fake implementation.
+type AccountsAPI interface {
+	ListAccounts(ctx context.Context, options *ListOptions) (*AccountsResponse, error)
+	ListAccountsIter(ctx context.Context, options *ListOptions) iter.Seq2[Account, error]
+}
+
+// BillingAPI is the interface of BillingService, to depend on in place of the concrete service.
+// See the dnsimplemock package for a fake implementation.
+type BillingAPI interface {
+	ListCharges(ctx context.Context, account string, options ListChargesOptions) (*ListChargesResponse, error)
+	ListChargesIter(ctx context.Context, account string, options ListChargesOptions) iter.Seq2[Charge, error]
+}
+
+// CertificatesAPI is the interface of CertificatesService, to depend on in place of the concrete service.
+// See the dnsimplemock package for a fake implementation.
+type CertificatesAPI interface {
+	ListCertificates(ctx context.Context, accountID, domainIdentifier string, options *ListOptions) (*CertificatesResponse, error)
+	ListCertificatesIter(ctx context.Context, accountID string, domainIdentifier string, options *ListOptions) iter.Seq2[Certificate, error]
+	GetCertificate(ctx context.Context, accountID, domainIdentifier string, certificateID int64) (*CertificateResponse, error)
+	DownloadCertificate(ctx context.Context, accountID, domainIdentifier string, certificateID int64) (*CertificateBundleResponse, error)
+	GetCertificatePrivateKey(ctx context.Context, accountID, domainIdentifier string, certificateID int64) (*CertificateBundleResponse, error)
+	PurchaseLetsencryptCertificate(ctx context.Context, accountID, domainIdentifier string, certificateAttributes LetsencryptCertificateAttributes) (*CertificatePurchaseResponse, error)
+	IssueLetsencryptCertificate(ctx context.Context, accountID, domainIdentifier string, certificateID int64) (*CertificateResponse, error)
+	PurchaseLetsencryptCertificateRenewal(ctx context.Context, accountID, domainIdentifier string, certificateID int64, certificateAttributes LetsencryptCertificateAttributes) (*CertificateRenewalResponse, error)
+	IssueLetsencryptCertificateRenewal(ctx context.Context, accountID, domainIdentifier string, certificateID, certificateRenewalID int64) (*CertificateResponse, error)
+}
+
+// ContactsAPI is the interface of ContactsService, to depend on in place of the concrete service.
+// See the dnsimplemock package for a fake implementation.
+type ContactsAPI interface {
+	ListContacts(ctx context.Context, accountID string, options *ListOptions) (*ContactsResponse, error)
+	ListContactsIter(ctx context.Context, accountID string, options *ListOptions) iter.Seq2[Contact, error]
+	CreateContact(ctx context.Context, accountID string, contactAttributes Contact) (*ContactResponse, error)
+	GetContact(ctx context.Context, accountID string, contactID int64) (*ContactResponse, error)
+	UpdateContact(ctx context.Context, accountID string, contactID int64, contactAttributes Contact) (*ContactResponse, error)
+	DeleteContact(ctx context.Context, accountID string, contactID int64) (*ContactResponse, error)
+}
+
+// DomainsAPI is the interface of DomainsService, to depend on in place of the concrete service.
+// See the dnsimplemock package for a fake implementation.
+type DomainsAPI interface {
+	ListDomains(ctx context.Context, accountID string, options *DomainListOptions) (*DomainsResponse, error)
+	ListDomainsIter(ctx context.Context, accountID string, options *DomainListOptions) iter.Seq2[Domain, error]
+	CreateDomain(ctx context.Context, accountID string, domainAttributes Domain) (*DomainResponse, error)
+	GetDomain(ctx context.Context, accountID string, domainIdentifier string) (*DomainResponse, error)
+	DeleteDomain(ctx context.Context, accountID string, domainIdentifier string) (*DomainResponse, error)
+	GetDomainResearchStatus(ctx context.Context, accountID string, domainName string) (*DomainResearchStatusResponse, error)
+	ListDelegationSignerRecords(ctx context.Context, accountID string, domainIdentifier string, options *ListOptions) (*DelegationSignerRecordsResponse, error)
+	ListDelegationSignerRecordsIter(ctx context.Context, accountID string, domainIdentifier string, options *ListOptions) iter.Seq2[DelegationSignerRecord, error]
+	CreateDelegationSignerRecord(ctx context.Context, accountID string, domainIdentifier string, dsRecordAttributes DelegationSignerRecord) (*DelegationSignerRecordResponse, error)
+	GetDelegationSignerRecord(ctx context.Context, accountID string, domainIdentifier string, dsRecordID int64) (*DelegationSignerRecordResponse, error)
+	DeleteDelegationSignerRecord(ctx context.Context, accountID string, domainIdentifier string, dsRecordID int64) (*DelegationSignerRecordResponse, error)
+	EnableDnssec(ctx context.Context, accountID string, domainIdentifier string) (*DnssecResponse, error)
+	DisableDnssec(ctx context.Context, accountID string, domainIdentifier string) (*DnssecResponse, error)
+	GetDnssec(ctx context.Context, accountID string, domainIdentifier string) (*DnssecResponse, error)
+	ListEmailForwards(ctx context.Context, accountID string, domainIdentifier string, options *ListOptions) (*EmailForwardsResponse, error)
+	ListEmailForwardsIter(ctx context.Context, accountID string, domainIdentifier string, options *ListOptions) iter.Seq2[EmailForward, error]
+	CreateEmailForward(ctx context.Context, accountID string, domainIdentifier string, forwardAttributes EmailForward) (*EmailForwardResponse, error)
+	GetEmailForward(ctx context.Context, accountID string, domainIdentifier string, forwardID int64) (*EmailForwardResponse, error)
+	DeleteEmailForward(ctx context.Context, accountID string, domainIdentifier string, forwardID int64) (*EmailForwardResponse, error)
+	InitiatePush(ctx context.Context, accountID, domainID string, pushAttributes DomainPushAttributes) (*DomainPushResponse, error)
+	ListPushes(ctx context.Context, accountID string, options *ListOptions) (*DomainPushesResponse, error)
+	ListPushesIter(ctx context.Context, accountID string, options *ListOptions) iter.Seq2[DomainPush, error]
+	AcceptPush(ctx context.Context, accountID string, pushID int64, pushAttributes DomainPushAttributes) (*DomainPushResponse, error)
+	RejectPush(ctx context.Context, accountID string, pushID int64) (*DomainPushResponse, error)
+}
+
+// DnsAnalyticsAPI is the interface of DnsAnalyticsService, to depend on in place of the concrete service.
+// See the dnsimplemock package for a fake implementation.
+type DnsAnalyticsAPI interface {
+	Query(ctx context.Context, accountID int64, options *DnsAnalyticsOptions) (*DnsAnalyticsResponse, error)
+}
+
+// OauthAPI is the interface of OauthService, to depend on in place of the concrete service.
+// See the dnsimplemock package for a fake implementation.
+type OauthAPI interface {
+	ExchangeAuthorizationForToken(authorization *ExchangeAuthorizationRequest) (*AccessToken, error)
+	ExchangeAuthorizationForTokenContext(ctx context.Context, authorization *ExchangeAuthorizationRequest) (*AccessToken, error)
+	AuthorizeURL(clientID string, options *AuthorizationOptions) string
+	NewFlow(clientID, clientSecret, redirectURI string, pkce bool) (*OauthFlow, error)
+	FlowAuthorizeURL(flow *OauthFlow) string
+	ExchangeRedirect(ctx context.Context, flow *OauthFlow, query url.Values) (*AccessToken, error)
+	NewClientFromRedirect(ctx context.Context, flow *OauthFlow, query url.Values, opts ...Option) (*Client, *AccessToken, error)
+	Config(clientID, clientSecret, redirectURI string) *oauth2.Config
+	LoopbackLogin(ctx context.Context, login *LoopbackLogin) (*AccessToken, error)
+}
+
+// RegistrarAPI is the interface of RegistrarService, to depend on in place of the concrete service.
+// See the dnsimplemock package for a fake implementation.
+type RegistrarAPI interface {
+	CheckDomain(ctx context.Context, accountID string, domainName string) (*DomainCheckResponse, error)
+	GetDomainPrices(ctx context.Context, accountID string, domainName string) (*DomainPriceResponse, error)
+	GetDomainRegistration(ctx context.Context, accountID string, domainName string, domainRegistrationID string) (*DomainRegistrationResponse, error)
+	RegisterDomain(ctx context.Context, accountID string, domainName string, input *RegisterDomainInput) (*DomainRegistrationResponse, error)
+	TransferDomain(ctx context.Context, accountID string, domainName string, input *TransferDomainInput) (*DomainTransferResponse, error)
+	GetDomainTransfer(ctx context.Context, accountID string, domainName string, domainTransferID int64) (*DomainTransferResponse, error)
+	CancelDomainTransfer(ctx context.Context, accountID string, domainName string, domainTransferID int64) (*DomainTransferResponse, error)
+	TransferDomainOut(ctx context.Context, accountID string, domainName string) (*DomainTransferOutResponse, error)
+	GetDomainRenewal(ctx context.Context, accountID string, domainName string, domainRenewalID string) (*DomainRenewalResponse, error)
+	RenewDomain(ctx context.Context, accountID string, domainName string, input *RenewDomainInput) (*DomainRenewalResponse, error)
+	RestoreDomain(ctx context.Context, accountID string, domainName string, input *RenewDomainInput) (*DomainRenewalResponse, error)
+	GetDomainRestore(ctx context.Context, accountID string, domainName string, domainRestoreID string) (*DomainRestoreResponse, error)
+	EnableDomainAutoRenewal(ctx context.Context, accountID string, domainName string) (*DomainResponse, error)
+	DisableDomainAutoRenewal(ctx context.Context, accountID string, domainName string) (*DomainResponse, error)
+	GetDomainDelegation(ctx context.Context, accountID string, domainName string) (*DelegationResponse, error)
+	ChangeDomainDelegation(ctx context.Context, accountID string, domainName string, newDelegation *Delegation) (*DelegationResponse, error)
+	ChangeDomainDelegationToVanity(ctx context.Context, accountID string, domainName string, newDelegation *Delegation) (*VanityDelegationResponse, error)
+	ChangeDomainDelegationFromVanity(ctx context.Context, accountID string, domainName string) (*VanityDelegationResponse, error)
+	GetDomainTransferLock(ctx context.Context, accountID string, domainIdentifier string) (*DomainTransferLockResponse, error)
+	EnableDomainTransferLock(ctx context.Context, accountID string, domainIdentifier string) (*DomainTransferLockResponse, error)
+	DisableDomainTransferLock(ctx context.Context, accountID string, domainIdentifier string) (*DomainTransferLockResponse, error)
+	ListRegistrantChange(ctx context.Context, accountID string, options *RegistrantChangeListOptions) (*RegistrantChangesListResponse, error)
+	ListRegistrantChangeIter(ctx context.Context, accountID string, options *RegistrantChangeListOptions) iter.Seq2[RegistrantChange, error]
+	CreateRegistrantChange(ctx context.Context, accountID string, input *CreateRegistrantChangeInput) (*RegistrantChangeResponse, error)
+	CheckRegistrantChange(ctx context.Context, accountID string, input *CheckRegistrantChangeInput) (*RegistrantChangeCheckResponse, error)
+	GetRegistrantChange(ctx context.Context, accountID string, registrantChange int) (*RegistrantChangeResponse, error)
+	DeleteRegistrantChange(ctx context.Context, accountID string, registrantChange int) (*RegistrantChangeDeleteResponse, error)
+	EnableWhoisPrivacy(ctx context.Context, accountID string, domainName string) (*WhoisPrivacyResponse, error)
+	DisableWhoisPrivacy(ctx context.Context, accountID string, domainName string) (*WhoisPrivacyResponse, error)
+}
+
+// ServicesAPI is the interface of ServicesService, to depend on in place of the concrete service.
+// See the dnsimplemock package for a fake implementation.
+type ServicesAPI interface {
+	ListServices(ctx context.Context, options *ListOptions) (*ServicesResponse, error)
+	ListServicesIter(ctx context.Context, options *ListOptions) iter.Seq2[Service, error]
+	GetService(ctx context.Context, serviceIdentifier string) (*ServiceResponse, error)
+	AppliedServices(ctx context.Context, accountID string, domainIdentifier string, options *ListOptions) (*ServicesResponse, error)
+	AppliedServicesIter(ctx context.Context, accountID string, domainIdentifier string, options *ListOptions) iter.Seq2[Service, error]
+	ApplyService(ctx context.Context, accountID string, serviceIdentifier string, domainIdentifier string, settings DomainServiceSettings) (*ServiceResponse, error)
+	UnapplyService(ctx context.Context, accountID string, serviceIdentifier string, domainIdentifier string) (*ServiceResponse, error)
+}
+
+// TemplatesAPI is the interface of TemplatesService, to depend on in place of the concrete service.
+// See the dnsimplemock package for a fake implementation.
+type TemplatesAPI interface {
+	ListTemplates(ctx context.Context, accountID string, options *ListOptions) (*TemplatesResponse, error)
+	ListTemplatesIter(ctx context.Context, accountID string, options *ListOptions) iter.Seq2[Template, error]
+	CreateTemplate(ctx context.Context, accountID string, templateAttributes Template) (*TemplateResponse, error)
+	GetTemplate(ctx context.Context, accountID string, templateIdentifier string) (*TemplateResponse, error)
+	UpdateTemplate(ctx context.Context, accountID string, templateIdentifier string, templateAttributes Template) (*TemplateResponse, error)
+	DeleteTemplate(ctx context.Context, accountID string, templateIdentifier string) (*TemplateResponse, error)
+	ApplyTemplate(ctx context.Context, accountID string, templateIdentifier string, domainIdentifier string) (*TemplateResponse, error)
+	ListTemplateRecords(ctx context.Context, accountID string, templateIdentifier string, options *ListOptions) (*TemplateRecordsResponse, error)
+	ListTemplateRecordsIter(ctx context.Context, accountID string, templateIdentifier string, options *ListOptions) iter.Seq2[TemplateRecord, error]
+	CreateTemplateRecord(ctx context.Context, accountID string, templateIdentifier string, templateRecordAttributes TemplateRecord) (*TemplateRecordResponse, error)
+	GetTemplateRecord(ctx context.Context, accountID string, templateIdentifier string, templateRecordID int64) (*TemplateRecordResponse, error)
+	DeleteTemplateRecord(ctx context.Context, accountID string, templateIdentifier string, templateRecordID int64) (*TemplateRecordResponse, error)
+}
+
+// TldsAPI is the interface of TldsService, to depend on in place of the concrete service.
+// See the dnsimplemock package for a fake implementation.
+type TldsAPI interface {
+	ListTlds(ctx context.Context, options *ListOptions) (*TldsResponse, error)
+	ListTldsIter(ctx context.Context, options *ListOptions) iter.Seq2[Tld, error]
+	GetTld(ctx context.Context, tld string) (*TldResponse, error)
+	GetTldExtendedAttributes(ctx context.Context, tld string) (*TldExtendedAttributesResponse, error)
+}
+
+// VanityNameServersAPI is the interface of VanityNameServersService, to depend on in place of the concrete service.
+// See the dnsimplemock package for a fake implementation.
+type VanityNameServersAPI interface {
+	EnableVanityNameServers(ctx context.Context, accountID string, domainIdentifier string) (*VanityNameServerResponse, error)
+	DisableVanityNameServers(ctx context.Context, accountID string, domainIdentifier string) (*VanityNameServerResponse, error)
+}
+
+// WebhooksAPI is the interface of WebhooksService, to depend on in place of the concrete service.
+// See the dnsimplemock package for a fake implementation.
+type WebhooksAPI interface {
+	ListWebhooks(ctx context.Context, accountID string, _ *ListOptions) (*WebhooksResponse, error)
+	ListWebhooksIter(ctx context.Context, accountID string, options *ListOptions) iter.Seq2[Webhook, error]
+	CreateWebhook(ctx context.Context, accountID string, webhookAttributes Webhook) (*WebhookResponse, error)
+	GetWebhook(ctx context.Context, accountID string, webhookID int64) (*WebhookResponse, error)
+	DeleteWebhook(ctx context.Context, accountID string, webhookID int64) (*WebhookResponse, error)
+}
+
+// ZonesAPI is the interface of ZonesService, to depend on in place of the concrete service.
+// See the dnsimplemock package for a fake implementation.
+type ZonesAPI interface {
+	CheckZoneDistribution(ctx context.Context, accountID string, zoneName string) (*ZoneDistributionResponse, error)
+	CheckZoneRecordDistribution(ctx context.Context, accountID string, zoneName string, recordID int64) (*ZoneDistributionResponse, error)
+	ListZones(ctx context.Context, accountID string, options *ZoneListOptions) (*ZonesResponse, error)
+	ListZonesIter(ctx context.Context, accountID string, options *ZoneListOptions) iter.Seq2[Zone, error]
+	GetZone(ctx context.Context, accountID string, zoneName string) (*ZoneResponse, error)
+	GetZoneFile(ctx context.Context, accountID string, zoneName string) (*ZoneFileResponse, error)
+	ActivateZoneDns(ctx context.Context, accountID string, zoneName string) (*ZoneResponse, error)
+	DeactivateZoneDns(ctx context.Context, accountID string, zoneName string) (*ZoneResponse, error)
+	ListRecords(ctx context.Context, accountID string, zoneName string, options *ZoneRecordListOptions) (*ZoneRecordsResponse, error)
+	ListRecordsIter(ctx context.Context, accountID string, zoneName string, options *ZoneRecordListOptions) iter.Seq2[ZoneRecord, error]
+	CreateRecord(ctx context.Context, accountID string, zoneName string, recordAttributes ZoneRecordAttributes) (*ZoneRecordResponse, error)
+	GetRecord(ctx context.Context, accountID string, zoneName string, recordID int64) (*ZoneRecordResponse, error)
+	UpdateRecord(ctx context.Context, accountID string, zoneName string, recordID int64, recordAttributes ZoneRecordAttributes) (*ZoneRecordResponse, error)
+	DeleteRecord(ctx context.Context, accountID string, zoneName string, recordID int64) (*ZoneRecordResponse, error)
+	BatchChangeZoneRecords(ctx context.Context, accountID string, zoneName string, request BatchChangeZoneRecordsRequest) (*BatchChangeZoneRecordsResponse, error)
+}
+
+var (
+	_ IdentityAPI          = (*IdentityService)(nil)
+	_ AccountsAPI          = (*AccountsService)(nil)
+	_ BillingAPI           = (*BillingService)(nil)
+	_ CertificatesAPI      = (*CertificatesService)(nil)
+	_ ContactsAPI          = (*ContactsService)(nil)
+	_ DomainsAPI           = (*DomainsService)(nil)
+	_ DnsAnalyticsAPI      = (*DnsAnalyticsService)(nil)
+	_ OauthAPI             = (*OauthService)(nil)
+	_ RegistrarAPI         = (*RegistrarService)(nil)
+	_ ServicesAPI          = (*ServicesService)(nil)
+	_ TemplatesAPI         = (*TemplatesService)(nil)
+	_ TldsAPI              = (*TldsService)(nil)
+	_ VanityNameServersAPI = (*VanityNameServersService)(nil)
+	_ WebhooksAPI          = (*WebhooksService)(nil)
+	_ ZonesAPI             = (*ZonesService)(nil)
+)
@@ -0,0 +1,110 @@
+package dnsimple
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// benchmarkTransport responds to every request with the same response,
+// to measure the client without the network.
+type benchmarkTransport struct {
+	status int
+	body   []byte
+}
+
+func (t *benchmarkTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+		req.Body.Close()
+	}
+	return &http.Response{
+		StatusCode: t.status,
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body:       io.NopCloser(bytes.NewReader(t.body)),
+		Request:    req,
+	}, nil
+}
+
+func newBenchmarkClient(status int, body string) *Client {
+	return NewClient(&http.Client{Transport: &benchmarkTransport{status: status, body: []byte(body)}}, WithBaseURL("https://api.example.com"))
+}
+
+// recordsPage returns the body of a page of n zone records.
+func recordsPage(n int) string {
+	var sb strings.Builder
+	sb.WriteString(`{"data":[`)
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			sb.WriteString(",")
+		}
+		fmt.Fprintf(&sb, `{"id":%d,"zone_id":"example.com","parent_id":null,"name":"www%d","content":"192.0.2.%d","ttl":3600,"priority":null,"type":"A","regions":["global"],"system_record":false,"created_at":"2016-03-22T10:20:53Z","updated_at":"2016-10-05T09:26:38Z"}`, i, i, i%256)
+	}
+	fmt.Fprintf(&sb, `],"pagination":{"current_page":1,"per_page":%d,"total_entries":%d,"total_pages":1}}`, n, n)
+	return sb.String()
+}
+
+func BenchmarkClient_GetZone(b *testing.B) {
+	client := newBenchmarkClient(http.StatusOK, `{"data":{"id":1,"account_id":1010,"name":"example.com","reverse":false,"secondary":false,"last_transferred_at":null,"active":true,"created_at":"2015-04-23T07:40:03Z","updated_at":"2015-04-23T07:40:03Z"}}`)
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := client.Zones.GetZone(ctx, "1010", "example.com"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkClient_ListRecords(b *testing.B) {
+	client := newBenchmarkClient(http.StatusOK, recordsPage(100))
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := client.Zones.ListRecords(ctx, "1010", "example.com", nil); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkClient_CreateRecord(b *testing.B) {
+	client := newBenchmarkClient(http.StatusCreated, `{"data":{"id":1,"zone_id":"example.com","name":"www","content":"192.0.2.1","ttl":3600,"type":"A","regions":["global"],"system_record":false}}`)
+	ctx := context.Background()
+	attributes := ZoneRecordAttributes{Name: String("www"), Type: "A", Content: "192.0.2.1", TTL: 3600}
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := client.Zones.CreateRecord(ctx, "1010", "example.com", attributes); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkClient_DeleteRecord(b *testing.B) {
+	client := newBenchmarkClient(http.StatusNoContent, ``)
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := client.Zones.DeleteRecord(ctx, "1010", "example.com", 1); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkClient_Error(b *testing.B) {
+	client := newBenchmarkClient(http.StatusBadRequest, `{"message":"Validation failed","errors":{"content":["can't be blank"]}}`)
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := client.Zones.GetZone(ctx, "1010", "example.com"); err == nil {
+			b.Fatal("expected an error")
+		}
+	}
+}
@@ -3,6 +3,7 @@ package dnsimple
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/shopspring/decimal"
 )
@@ -16,6 +17,12 @@ type ListChargesOptions struct {
 
 	// Sort results. Default sorting is by invoiced ascending.
 	Sort string `url:"sort,omitempty"`
+
+	// The page to return
+	Page *int `url:"page,omitempty"`
+
+	// The number of entries to return per page
+	PerPage *int `url:"per_page,omitempty"`
 }
 
 type ListChargesResponse struct {
@@ -56,7 +63,7 @@ func (s *BillingService) ListCharges(ctx context.Context, account string, option
 		return nil, err
 	}
 
-	resp, err := s.client.get(ctx, path, listResponse)
+	resp, err := s.client.get(ctx, "Billing.ListCharges", path, listResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -64,3 +71,20 @@ func (s *BillingService) ListCharges(ctx context.Context, account string, option
 	listResponse.HTTPResponse = resp
 	return listResponse, nil
 }
+
+// ListChargesIter returns an iterator over the billing charges for the account,
+// that fetches the pages lazily as the iteration progresses.
+//
+// See ListCharges for the available options, and All to collect the results.
+func (s *BillingService) ListChargesIter(ctx context.Context, account string, options ListChargesOptions) iter.Seq2[Charge, error] {
+	return paginate(ctx, s.client, firstPage(&ListOptions{Page: options.Page}), func(ctx context.Context, page int) ([]Charge, *Response, error) {
+		opts := options
+		opts.Page = &page
+
+		resp, err := s.ListCharges(ctx, account, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Data, &resp.Response, nil
+	})
+}
@@ -0,0 +1,31 @@
+package dnsimple
+
+import (
+	"bytes"
+	"sync"
+)
+
+// maxPooledBufferSize is the capacity above which a buffer is not returned to the pool,
+// so that an occasional large body doesn't stay in memory.
+const maxPooledBufferSize = 1 << 20
+
+// bufferPool holds the buffers used to read the response bodies.
+var bufferPool = sync.Pool{
+	New: func() interface{} { return new(bytes.Buffer) },
+}
+
+// getBuffer returns an empty buffer from the pool.
+func getBuffer() *bytes.Buffer {
+	buf := bufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
+	return buf
+}
+
+// putBuffer returns the buffer to the pool.
+// The buffer and its bytes must not be used afterwards.
+func putBuffer(buf *bytes.Buffer) {
+	if buf.Cap() > maxPooledBufferSize {
+		return
+	}
+	bufferPool.Put(buf)
+}
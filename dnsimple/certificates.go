@@ -3,6 +3,7 @@ package dnsimple
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 // CertificatesService handles communication with the certificate related
@@ -126,7 +127,7 @@ func (s *CertificatesService) ListCertificates(ctx context.Context, accountID, d
 		return nil, err
 	}
 
-	resp, err := s.client.get(ctx, path, certificatesResponse)
+	resp, err := s.client.get(ctx, "Certificates.ListCertificates", path, certificatesResponse)
 	if err != nil {
 		return certificatesResponse, err
 	}
@@ -135,6 +136,28 @@ func (s *CertificatesService) ListCertificates(ctx context.Context, accountID, d
 	return certificatesResponse, nil
 }
 
+// ListCertificatesIter returns an iterator over the certificates for a domain,
+// that fetches the pages lazily as the iteration progresses.
+//
+// See ListCertificates for the available options, and All to collect the results.
+func (s *CertificatesService) ListCertificatesIter(ctx context.Context, accountID string, domainIdentifier string, options *ListOptions) iter.Seq2[Certificate, error] {
+	opts := ListOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	return paginate(ctx, s.client, firstPage(&opts), func(ctx context.Context, page int) ([]Certificate, *Response, error) {
+		opts := opts
+		opts.Page = &page
+
+		resp, err := s.ListCertificates(ctx, accountID, domainIdentifier, &opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Data, &resp.Response, nil
+	})
+}
+
 // GetCertificate gets the details of a certificate.
 //
 // See https://developer.dnsimple.com/v2/certificates#getCertificate
@@ -142,7 +165,7 @@ func (s *CertificatesService) GetCertificate(ctx context.Context, accountID, dom
 	path := versioned(certificatePath(accountID, domainIdentifier, certificateID))
 	certificateResponse := &CertificateResponse{}
 
-	resp, err := s.client.get(ctx, path, certificateResponse)
+	resp, err := s.client.get(ctx, "Certificates.GetCertificate", path, certificateResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -159,7 +182,7 @@ func (s *CertificatesService) DownloadCertificate(ctx context.Context, accountID
 	path := versioned(certificatePath(accountID, domainIdentifier, certificateID) + "/download")
 	certificateBundleResponse := &CertificateBundleResponse{}
 
-	resp, err := s.client.get(ctx, path, certificateBundleResponse)
+	resp, err := s.client.get(ctx, "Certificates.DownloadCertificate", path, certificateBundleResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -175,7 +198,7 @@ func (s *CertificatesService) GetCertificatePrivateKey(ctx context.Context, acco
 	path := versioned(certificatePath(accountID, domainIdentifier, certificateID) + "/private_key")
 	certificateBundleResponse := &CertificateBundleResponse{}
 
-	resp, err := s.client.get(ctx, path, certificateBundleResponse)
+	resp, err := s.client.get(ctx, "Certificates.GetCertificatePrivateKey", path, certificateBundleResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -191,7 +214,7 @@ func (s *CertificatesService) PurchaseLetsencryptCertificate(ctx context.Context
 	path := versioned(letsencryptCertificatePath(accountID, domainIdentifier, 0))
 	certificatePurchaseResponse := &CertificatePurchaseResponse{}
 
-	resp, err := s.client.post(ctx, path, certificateAttributes, certificatePurchaseResponse)
+	resp, err := s.client.post(ctx, "Certificates.PurchaseLetsencryptCertificate", path, certificateAttributes, certificatePurchaseResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -207,7 +230,7 @@ func (s *CertificatesService) IssueLetsencryptCertificate(ctx context.Context, a
 	path := versioned(letsencryptCertificatePath(accountID, domainIdentifier, certificateID) + "/issue")
 	certificateResponse := &CertificateResponse{}
 
-	resp, err := s.client.post(ctx, path, nil, certificateResponse)
+	resp, err := s.client.post(ctx, "Certificates.IssueLetsencryptCertificate", path, nil, certificateResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -223,7 +246,7 @@ func (s *CertificatesService) PurchaseLetsencryptCertificateRenewal(ctx context.
 	path := versioned(letsencryptCertificatePath(accountID, domainIdentifier, certificateID) + "/renewals")
 	certificateRenewalResponse := &CertificateRenewalResponse{}
 
-	resp, err := s.client.post(ctx, path, certificateAttributes, certificateRenewalResponse)
+	resp, err := s.client.post(ctx, "Certificates.PurchaseLetsencryptCertificateRenewal", path, certificateAttributes, certificateRenewalResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -239,7 +262,7 @@ func (s *CertificatesService) IssueLetsencryptCertificateRenewal(ctx context.Con
 	path := versioned(letsencryptCertificatePath(accountID, domainIdentifier, certificateID) + fmt.Sprintf("/renewals/%d/issue", certificateRenewalID))
 	certificateResponse := &CertificateResponse{}
 
-	resp, err := s.client.post(ctx, path, nil, certificateResponse)
+	resp, err := s.client.post(ctx, "Certificates.IssueLetsencryptCertificateRenewal", path, nil, certificateResponse)
 	if err != nil {
 		return nil, err
 	}
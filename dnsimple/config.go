@@ -0,0 +1,372 @@
+package dnsimple
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Environment variables read by LoadConfig.
+const (
+	EnvToken       = "DNSIMPLE_TOKEN"
+	EnvAccountID   = "DNSIMPLE_ACCOUNT_ID"
+	EnvEnvironment = "DNSIMPLE_ENVIRONMENT"
+	EnvBaseURL     = "DNSIMPLE_BASE_URL"
+	EnvUserAgent   = "DNSIMPLE_USER_AGENT"
+	EnvProfile     = "DNSIMPLE_PROFILE"
+	EnvConfigFile  = "DNSIMPLE_CONFIG_FILE"
+)
+
+// DefaultProfile is the profile used when none is selected.
+const DefaultProfile = "default"
+
+// Config holds the settings to create a Client.
+//
+// The profile file is a TOML file with a table per profile:
+//
+//	[default]
+//	token = "dnsimple_a_..."
+//	account_id = "1010"
+//
+//	[sandbox]
+//	token = "dnsimple_a_..."
+//	account_id = "2020"
+//	environment = "sandbox"
+//	user_agent = "my-tool/1.0"
+//
+// A profile can use base_url instead of environment to point to a custom endpoint.
+type Config struct {
+	// Profile is the name of the profile to read from the profile file.
+	Profile string
+
+	// File is the path of the profile file.
+	File string
+
+	// Token is the OAuth access token, or account/user API token.
+	Token string
+
+	// AccountID is the ID of the account to work with.
+	AccountID string
+
+	// Environment is the API environment. It is mutually exclusive with BaseURL.
+	Environment Environment
+
+	// BaseURL is a custom base URL for the API. It is mutually exclusive with Environment.
+	BaseURL string
+
+	// UserAgent is the custom user agent, see Client.SetUserAgent.
+	UserAgent string
+}
+
+// DefaultConfigFile returns the default path of the profile file,
+// $XDG_CONFIG_HOME/dnsimple/config.toml, or ~/.config/dnsimple/config.toml
+// when XDG_CONFIG_HOME is not set.
+func DefaultConfigFile() (string, error) {
+	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+		return filepath.Join(dir, "dnsimple", "config.toml"), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".config", "dnsimple", "config.toml"), nil
+}
+
+// LoadConfig resolves the configuration, looking for each setting in order in:
+//
+//  1. the explicit config, for the fields that are set;
+//  2. the DNSIMPLE_* environment variables, e.g. DNSIMPLE_TOKEN and DNSIMPLE_BASE_URL;
+//  3. the profile file.
+//
+// The profile is explicit.Profile, DNSIMPLE_PROFILE or DefaultProfile,
+// and the profile file is explicit.File, DNSIMPLE_CONFIG_FILE or DefaultConfigFile.
+// It is not an error if the default profile file or the default profile don't exist.
+//
+// The environment and the base URL are resolved together:
+// they are taken from the first source that sets either of them.
+func LoadConfig(explicit Config) (*Config, error) {
+	env, err := configFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := explicit
+	cfg.Profile = firstNonEmpty(explicit.Profile, env.Profile)
+	cfg.File = firstNonEmpty(explicit.File, env.File)
+
+	profile, err := loadProfile(cfg.File, cfg.Profile)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Profile == "" {
+		cfg.Profile = DefaultProfile
+	}
+
+	for _, source := range []*Config{&env, profile} {
+		cfg.Token = firstNonEmpty(cfg.Token, source.Token)
+		cfg.AccountID = firstNonEmpty(cfg.AccountID, source.AccountID)
+		cfg.UserAgent = firstNonEmpty(cfg.UserAgent, source.UserAgent)
+		if cfg.Environment == "" && cfg.BaseURL == "" {
+			cfg.Environment = source.Environment
+			cfg.BaseURL = source.BaseURL
+		}
+	}
+
+	if cfg.Environment != "" {
+		if _, err := cfg.Environment.BaseURL(); err != nil {
+			return nil, err
+		}
+	}
+	return &cfg, nil
+}
+
+// Options returns the client options for the configuration.
+func (cfg *Config) Options() []Option {
+	var opts []Option
+	if cfg.Token != "" {
+		opts = append(opts, WithToken(cfg.Token))
+	}
+	if cfg.Environment != "" {
+		opts = append(opts, WithEnvironment(cfg.Environment))
+	}
+	if cfg.BaseURL != "" {
+		opts = append(opts, WithBaseURL(cfg.BaseURL))
+	}
+	if cfg.UserAgent != "" {
+		opts = append(opts, WithUserAgent(cfg.UserAgent))
+	}
+	return opts
+}
+
+// NewClientFromConfig resolves the configuration with LoadConfig,
+// and returns a client configured with it, along with the ID of the account to work with.
+//
+// The additional options are applied after the ones of the configuration,
+// e.g. to set a logger or a retry policy.
+//
+// When the configuration doesn't set the account ID, it is resolved with Client.ForCurrentAccount.
+func NewClientFromConfig(ctx context.Context, explicit Config, opts ...Option) (*Client, string, error) {
+	cfg, err := LoadConfig(explicit)
+	if err != nil {
+		return nil, "", err
+	}
+	if cfg.Token == "" {
+		return nil, "", fmt.Errorf("dnsimple: no token found for profile %q: set %s or add a token to the profile file", cfg.Profile, EnvToken)
+	}
+
+	client := NewClient(nil, append(cfg.Options(), opts...)...)
+	if client.configErr != nil {
+		return nil, "", client.configErr
+	}
+
+	if cfg.AccountID != "" {
+		return client, cfg.AccountID, nil
+	}
+	account, err := client.ForCurrentAccount(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	return client, account.AccountID, nil
+}
+
+// configFromEnv returns the configuration set by the environment variables.
+func configFromEnv() (Config, error) {
+	cfg := Config{
+		Profile:   os.Getenv(EnvProfile),
+		File:      os.Getenv(EnvConfigFile),
+		Token:     os.Getenv(EnvToken),
+		AccountID: os.Getenv(EnvAccountID),
+		BaseURL:   os.Getenv(EnvBaseURL),
+		UserAgent: os.Getenv(EnvUserAgent),
+	}
+	if value := os.Getenv(EnvEnvironment); value != "" {
+		env, err := ParseEnvironment(value)
+		if err != nil {
+			return Config{}, fmt.Errorf("%s: %w", EnvEnvironment, err)
+		}
+		cfg.Environment = env
+	}
+	return cfg, nil
+}
+
+// loadProfile reads the profile from the profile file.
+//
+// An empty path or name selects the default one, that may not exist.
+func loadProfile(path, name string) (*Config, error) {
+	explicitFile, explicitProfile := path != "", name != ""
+	if !explicitFile {
+		var err error
+		if path, err = DefaultConfigFile(); err != nil {
+			return &Config{}, nil
+		}
+	}
+	if !explicitProfile {
+		name = DefaultProfile
+	}
+
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) && !explicitFile && !explicitProfile {
+		return &Config{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	profiles, err := parseProfiles(f)
+	if err != nil {
+		return nil, fmt.Errorf("dnsimple: %s: %w", path, err)
+	}
+
+	profile, ok := profiles[name]
+	if !ok {
+		if explicitProfile {
+			return nil, fmt.Errorf("dnsimple: %s: profile %q not found", path, name)
+		}
+		return &Config{}, nil
+	}
+
+	cfg := &Config{
+		Token:     profile["token"],
+		AccountID: profile["account_id"],
+		BaseURL:   profile["base_url"],
+		UserAgent: profile["user_agent"],
+	}
+	if value := profile["environment"]; value != "" {
+		env, err := ParseEnvironment(value)
+		if err != nil {
+			return nil, fmt.Errorf("dnsimple: %s: profile %q: %w", path, name, err)
+		}
+		cfg.Environment = env
+	}
+	if cfg.Environment != "" && cfg.BaseURL != "" {
+		return nil, fmt.Errorf("dnsimple: %s: profile %q sets both environment and base_url", path, name)
+	}
+	return cfg, nil
+}
+
+// parseProfiles parses the profiles of a profile file.
+//
+// It supports the subset of TOML used by the profile files: tables with
+// string, integer and boolean values, and comments.
+func parseProfiles(r io.Reader) (map[string]map[string]string, error) {
+	profiles := map[string]map[string]string{}
+	var profile map[string]string
+
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(stripComment(scanner.Text()))
+		if line == "" {
+			continue
+		}
+
+		if name, ok := strings.CutPrefix(line, "["); ok {
+			name, ok = strings.CutSuffix(name, "]")
+			if !ok {
+				return nil, fmt.Errorf("line %d: invalid table header", n)
+			}
+			name, err := parseKey(strings.TrimSpace(name))
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", n, err)
+			}
+			if _, ok := profiles[name]; ok {
+				return nil, fmt.Errorf("line %d: duplicate profile %q", n, name)
+			}
+			profile = map[string]string{}
+			profiles[name] = profile
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected key = value", n)
+		}
+		if profile == nil {
+			return nil, fmt.Errorf("line %d: key outside of a profile", n)
+		}
+		key, err := parseKey(strings.TrimSpace(key))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", n, err)
+		}
+		value, err = parseValue(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s: %w", n, key, err)
+		}
+		profile[key] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
+
+// stripComment removes the comment at the end of a line, if any.
+func stripComment(line string) string {
+	var quote byte
+	for i := 0; i < len(line); i++ {
+		switch c := line[i]; {
+		case quote == '"' && c == '\\':
+			i++
+		case quote != 0 && c == quote:
+			quote = 0
+		case quote == 0 && (c == '"' || c == '\''):
+			quote = c
+		case quote == 0 && c == '#':
+			return line[:i]
+		}
+	}
+	return line
+}
+
+// parseKey parses a bare or quoted key.
+func parseKey(key string) (string, error) {
+	if strings.HasPrefix(key, `"`) || strings.HasPrefix(key, "'") {
+		return parseValue(key)
+	}
+	if key == "" || strings.ContainsFunc(key, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' || r == '-')
+	}) {
+		return "", fmt.Errorf("invalid key %q", key)
+	}
+	return key, nil
+}
+
+// parseValue parses a string, integer or boolean value.
+func parseValue(value string) (string, error) {
+	switch {
+	case strings.HasPrefix(value, `"`):
+		s, err := strconv.Unquote(value)
+		if err != nil {
+			return "", fmt.Errorf("invalid string %s", value)
+		}
+		return s, nil
+	case strings.HasPrefix(value, "'"):
+		if len(value) < 2 || !strings.HasSuffix(value, "'") || strings.Contains(value[1:len(value)-1], "'") {
+			return "", fmt.Errorf("invalid string %s", value)
+		}
+		return value[1 : len(value)-1], nil
+	case value == "true" || value == "false":
+		return value, nil
+	}
+	if _, err := strconv.ParseInt(strings.ReplaceAll(value, "_", ""), 10, 64); err != nil {
+		return "", fmt.Errorf("unsupported value %s", value)
+	}
+	return strings.ReplaceAll(value, "_", ""), nil
+}
+
+// firstNonEmpty returns the first non-empty value.
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,207 @@
+package dnsimple
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupConfigEnv isolates the test from the environment variables
+// and the profile file of the user.
+func setupConfigEnv(t *testing.T) string {
+	t.Helper()
+	for _, name := range []string{EnvToken, EnvAccountID, EnvEnvironment, EnvBaseURL, EnvUserAgent, EnvProfile, EnvConfigFile} {
+		t.Setenv(name, "")
+	}
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	return dir
+}
+
+func writeConfigFile(t *testing.T, path, content string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+}
+
+const testConfigFile = `
+# DNSimple profiles
+[default]
+token = "default-token" # inline comment
+account_id = 1010
+user_agent = 'my-tool/1.0'
+
+[sandbox]
+token = "sandbox-token"
+account_id = "2020"
+environment = "sandbox"
+
+["proxy"]
+token = "proxy-token"
+base_url = "https://dnsimple.example.com#api"
+`
+
+func TestLoadConfig_DefaultProfile(t *testing.T) {
+	dir := setupConfigEnv(t)
+	writeConfigFile(t, filepath.Join(dir, "dnsimple", "config.toml"), testConfigFile)
+
+	cfg, err := LoadConfig(Config{})
+
+	require.NoError(t, err)
+	assert.Equal(t, DefaultProfile, cfg.Profile)
+	assert.Equal(t, "default-token", cfg.Token)
+	assert.Equal(t, "1010", cfg.AccountID)
+	assert.Equal(t, "my-tool/1.0", cfg.UserAgent)
+	assert.Equal(t, Environment(""), cfg.Environment)
+	assert.Equal(t, "", cfg.BaseURL)
+}
+
+func TestLoadConfig_Profiles(t *testing.T) {
+	dir := setupConfigEnv(t)
+	path := filepath.Join(dir, "custom.toml")
+	writeConfigFile(t, path, testConfigFile)
+
+	cfg, err := LoadConfig(Config{File: path, Profile: "sandbox"})
+	require.NoError(t, err)
+	assert.Equal(t, "sandbox-token", cfg.Token)
+	assert.Equal(t, "2020", cfg.AccountID)
+	assert.Equal(t, Sandbox, cfg.Environment)
+
+	t.Setenv(EnvConfigFile, path)
+	t.Setenv(EnvProfile, "proxy")
+	cfg, err = LoadConfig(Config{})
+	require.NoError(t, err)
+	assert.Equal(t, "proxy", cfg.Profile)
+	assert.Equal(t, "proxy-token", cfg.Token)
+	assert.Equal(t, "https://dnsimple.example.com#api", cfg.BaseURL)
+}
+
+func TestLoadConfig_Precedence(t *testing.T) {
+	dir := setupConfigEnv(t)
+	path := filepath.Join(dir, "config.toml")
+	writeConfigFile(t, path, testConfigFile)
+	t.Setenv(EnvConfigFile, path)
+	t.Setenv(EnvProfile, "sandbox")
+	t.Setenv(EnvToken, "env-token")
+	t.Setenv(EnvBaseURL, "https://env.example.com")
+
+	cfg, err := LoadConfig(Config{AccountID: "3030"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "env-token", cfg.Token)
+	assert.Equal(t, "3030", cfg.AccountID)
+	// The environment of the profile doesn't conflict with the base URL of the environment variable.
+	assert.Equal(t, "https://env.example.com", cfg.BaseURL)
+	assert.Equal(t, Environment(""), cfg.Environment)
+
+	cfg, err = LoadConfig(Config{Token: "explicit-token", Environment: Production})
+
+	require.NoError(t, err)
+	assert.Equal(t, "explicit-token", cfg.Token)
+	assert.Equal(t, "2020", cfg.AccountID)
+	assert.Equal(t, Production, cfg.Environment)
+	assert.Equal(t, "", cfg.BaseURL)
+}
+
+func TestLoadConfig_NoProfileFile(t *testing.T) {
+	setupConfigEnv(t)
+	t.Setenv(EnvToken, "env-token")
+	t.Setenv(EnvEnvironment, "Sandbox")
+
+	cfg, err := LoadConfig(Config{})
+
+	require.NoError(t, err)
+	assert.Equal(t, "env-token", cfg.Token)
+	assert.Equal(t, Sandbox, cfg.Environment)
+
+	_, err = LoadConfig(Config{Profile: "work"})
+	assert.Error(t, err)
+}
+
+func TestLoadConfig_Errors(t *testing.T) {
+	dir := setupConfigEnv(t)
+	path := filepath.Join(dir, "config.toml")
+	writeConfigFile(t, path, testConfigFile)
+
+	_, err := LoadConfig(Config{File: path, Profile: "work"})
+	assert.EqualError(t, err, "dnsimple: "+path+`: profile "work" not found`)
+
+	t.Setenv(EnvEnvironment, "staging")
+	_, err = LoadConfig(Config{File: path})
+	assert.EqualError(t, err, `DNSIMPLE_ENVIRONMENT: dnsimple: unknown environment "staging"`)
+}
+
+func TestParseProfiles(t *testing.T) {
+	profiles, err := parseProfiles(strings.NewReader(testConfigFile))
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]map[string]string{
+		"default": {"token": "default-token", "account_id": "1010", "user_agent": "my-tool/1.0"},
+		"sandbox": {"token": "sandbox-token", "account_id": "2020", "environment": "sandbox"},
+		"proxy":   {"token": "proxy-token", "base_url": "https://dnsimple.example.com#api"},
+	}, profiles)
+}
+
+func TestParseProfiles_Errors(t *testing.T) {
+	tests := map[string]string{
+		"token = \"x\"":                     "line 1: key outside of a profile",
+		"[default\ntoken = \"x\"":           "line 1: invalid table header",
+		"[default]\ntoken":                  "line 2: expected key = value",
+		"[default]\ntoken = x":              "line 2: token: unsupported value x",
+		"[default]\ntoken = \"x":            "line 2: token: invalid string \"x",
+		"[default]\n[default]":              "line 2: duplicate profile \"default\"",
+		"[default]\ntoken key = \"x\"":      "line 2: invalid key \"token key\"",
+		"[default]\ntoken = [\"a\", \"b\"]": "line 2: token: unsupported value [\"a\", \"b\"]",
+	}
+
+	for input, want := range tests {
+		_, err := parseProfiles(strings.NewReader(input))
+		assert.EqualError(t, err, want, input)
+	}
+}
+
+func TestNewClientFromConfig(t *testing.T) {
+	setupConfigEnv(t)
+	setupMockServer()
+	defer teardownMockServer()
+
+	mux.HandleFunc("/v2/whoami", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer env-token", r.Header.Get("Authorization"))
+		assert.Equal(t, "my-tool/1.0 "+defaultUserAgent, r.Header.Get("User-Agent"))
+		httpResponse := httpResponseFixture(t, "/api/whoami/success-account.http")
+
+		w.WriteHeader(httpResponse.StatusCode)
+		_, _ = io.Copy(w, httpResponse.Body)
+	})
+
+	t.Setenv(EnvToken, "env-token")
+	t.Setenv(EnvBaseURL, server.URL)
+	t.Setenv(EnvUserAgent, "my-tool/1.0")
+
+	c, accountID, err := NewClientFromConfig(context.Background(), Config{}, WithPagePrefetch(2))
+
+	require.NoError(t, err)
+	assert.Equal(t, "1", accountID)
+	assert.Equal(t, server.URL, c.BaseURL)
+	assert.Equal(t, 2, c.PagePrefetch)
+
+	_, accountID, err = NewClientFromConfig(context.Background(), Config{AccountID: "1010"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "1010", accountID)
+}
+
+func TestNewClientFromConfig_NoToken(t *testing.T) {
+	setupConfigEnv(t)
+
+	_, _, err := NewClientFromConfig(context.Background(), Config{})
+
+	assert.EqualError(t, err, `dnsimple: no token found for profile "default": set DNSIMPLE_TOKEN or add a token to the profile file`)
+}
@@ -3,6 +3,7 @@ package dnsimple
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 // ContactsService handles communication with the contact related
@@ -67,7 +68,7 @@ func (s *ContactsService) ListContacts(ctx context.Context, accountID string, op
 		return nil, err
 	}
 
-	resp, err := s.client.get(ctx, path, contactsResponse)
+	resp, err := s.client.get(ctx, "Contacts.ListContacts", path, contactsResponse)
 	if err != nil {
 		return contactsResponse, err
 	}
@@ -76,6 +77,28 @@ func (s *ContactsService) ListContacts(ctx context.Context, accountID string, op
 	return contactsResponse, nil
 }
 
+// ListContactsIter returns an iterator over the contacts for an account,
+// that fetches the pages lazily as the iteration progresses.
+//
+// See ListContacts for the available options, and All to collect the results.
+func (s *ContactsService) ListContactsIter(ctx context.Context, accountID string, options *ListOptions) iter.Seq2[Contact, error] {
+	opts := ListOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	return paginate(ctx, s.client, firstPage(&opts), func(ctx context.Context, page int) ([]Contact, *Response, error) {
+		opts := opts
+		opts.Page = &page
+
+		resp, err := s.ListContacts(ctx, accountID, &opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Data, &resp.Response, nil
+	})
+}
+
 // CreateContact creates a new contact.
 //
 // See https://developer.dnsimple.com/v2/contacts/#create
@@ -83,7 +106,7 @@ func (s *ContactsService) CreateContact(ctx context.Context, accountID string, c
 	path := versioned(contactPath(accountID, 0))
 	contactResponse := &ContactResponse{}
 
-	resp, err := s.client.post(ctx, path, contactAttributes, contactResponse)
+	resp, err := s.client.post(ctx, "Contacts.CreateContact", path, contactAttributes, contactResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -99,7 +122,7 @@ func (s *ContactsService) GetContact(ctx context.Context, accountID string, cont
 	path := versioned(contactPath(accountID, contactID))
 	contactResponse := &ContactResponse{}
 
-	resp, err := s.client.get(ctx, path, contactResponse)
+	resp, err := s.client.get(ctx, "Contacts.GetContact", path, contactResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -115,7 +138,7 @@ func (s *ContactsService) UpdateContact(ctx context.Context, accountID string, c
 	path := versioned(contactPath(accountID, contactID))
 	contactResponse := &ContactResponse{}
 
-	resp, err := s.client.patch(ctx, path, contactAttributes, contactResponse)
+	resp, err := s.client.patch(ctx, "Contacts.UpdateContact", path, contactAttributes, contactResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -131,7 +154,7 @@ func (s *ContactsService) DeleteContact(ctx context.Context, accountID string, c
 	path := versioned(contactPath(accountID, contactID))
 	contactResponse := &ContactResponse{}
 
-	resp, err := s.client.delete(ctx, path, nil, nil)
+	resp, err := s.client.delete(ctx, "Contacts.DeleteContact", path, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -92,7 +92,7 @@ func (s *DnsAnalyticsService) Query(ctx context.Context, accountID int64, option
 		return nil, err
 	}
 
-	resp, err := s.client.get(ctx, path, dnsAnalyticsResponse)
+	resp, err := s.client.get(ctx, "DnsAnalytics.Query", path, dnsAnalyticsResponse)
 	if err != nil {
 		return dnsAnalyticsResponse, err
 	}
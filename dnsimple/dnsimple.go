@@ -9,7 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"reflect"
@@ -50,6 +50,19 @@ type Client struct {
 	// UserAgent used when communicating with the DNSimple API.
 	UserAgent string
 
+	// RetryPolicy used to retry requests that failed with a 429 or 5xx response.
+	// Retries are disabled when nil. See DefaultRetryPolicy.
+	RetryPolicy *RetryPolicy
+
+	// RateLimiter used to throttle requests according to the account rate limit.
+	// Requests are not throttled when nil. See NewRateLimiter.
+	RateLimiter *RateLimiter
+
+	// PagePrefetch is the maximum number of pages the *Iter methods fetch concurrently,
+	// once the first response reports the total number of pages.
+	// The pages are still returned in order. Values lower than 2 disable prefetching.
+	PagePrefetch int
+
 	// Services used for talking to different parts of the DNSimple API.
 	Identity          *IdentityService
 	Accounts          *AccountsService
@@ -67,8 +80,30 @@ type Client struct {
 	Webhooks          *WebhooksService
 	Zones             *ZonesService
 
+	// Logger used to log the API calls.
+	// Every request is logged at slog.LevelDebug, with the method, path, status, duration,
+	// rate limit and request ID as attributes. Headers and bodies are logged at LogLevelTrace,
+	// with credentials and private keys redacted. Logging is disabled when nil.
+	Logger *slog.Logger
+
+	// UnknownFields, when set, is called with the fields of the response bodies
+	// that the result types don't decode, without failing the call.
+	// See WithStrictDecoding.
+	UnknownFields UnknownFieldsFunc
+
 	// Set to true to output debugging logs during API calls
+	//
+	// Deprecated: Use Logger instead.
 	Debug bool
+
+	// middleware is the chain of Middleware the API calls go through.
+	middleware []Middleware
+
+	// configErr is the error returned by the options passed to NewClient, if any.
+	configErr error
+
+	// current is the account resolved by ForCurrentAccount.
+	current currentAccount
 }
 
 // ListOptions contains the common options you can pass to a List method
@@ -88,9 +123,16 @@ type ListOptions struct {
 
 // NewClient returns a new DNSimple API client.
 //
-// To authenticate you must provide an http.Client that will perform authentication
-// for you with one of the currently supported mechanisms: OAuth or HTTP Basic.
-func NewClient(httpClient *http.Client) *Client {
+// To authenticate you must either provide an http.Client that will perform authentication
+// for you with one of the currently supported mechanisms: OAuth or HTTP Basic,
+// or use the WithToken or WithBasicAuth options. The httpClient can be nil when
+// the options are used.
+//
+//	client := dnsimple.NewClient(nil, dnsimple.WithToken(token), dnsimple.WithSandbox())
+//
+// If the options are invalid or conflicting, such as an empty token, every API call made by the client
+// returns the configuration error, also returned by Client.Err.
+func NewClient(httpClient *http.Client, opts ...Option) *Client {
 	c := &Client{httpClient: httpClient, BaseURL: defaultBaseURL}
 	c.Identity = &IdentityService{client: c}
 	c.Accounts = &AccountsService{client: c}
@@ -107,9 +149,15 @@ func NewClient(httpClient *http.Client) *Client {
 	c.VanityNameServers = &VanityNameServersService{client: c}
 	c.Webhooks = &WebhooksService{client: c}
 	c.Zones = &ZonesService{client: c}
+	c.configErr = c.configure(opts)
 	return c
 }
 
+// Err returns the error of the options passed to NewClient, or nil if they are valid.
+func (c *Client) Err() error {
+	return c.configErr
+}
+
 // SetUserAgent overrides the default UserAgent.
 //
 // When a custom user agent is provided, the final user agent is the combination of the custom user agent
@@ -142,54 +190,57 @@ func versioned(path string) string {
 	return fmt.Sprintf("/%s/%s", apiVersion, strings.Trim(path, "/"))
 }
 
-func (c *Client) get(ctx context.Context, path string, obj interface{}) (*http.Response, error) {
-	return c.makeRequest(ctx, http.MethodGet, path, nil, obj, nil)
+func (c *Client) get(ctx context.Context, operation, path string, obj interface{}) (*http.Response, error) {
+	return c.makeRequest(ctx, operation, http.MethodGet, path, nil, obj, nil)
 }
 
-func (c *Client) post(ctx context.Context, path string, payload, obj interface{}) (*http.Response, error) {
-	return c.makeRequest(ctx, http.MethodPost, path, payload, obj, nil)
+func (c *Client) post(ctx context.Context, operation, path string, payload, obj interface{}) (*http.Response, error) {
+	return c.makeRequest(ctx, operation, http.MethodPost, path, payload, obj, nil)
 }
 
-func (c *Client) put(ctx context.Context, path string, payload, obj interface{}) (*http.Response, error) {
-	return c.makeRequest(ctx, http.MethodPut, path, payload, obj, nil)
+func (c *Client) put(ctx context.Context, operation, path string, payload, obj interface{}) (*http.Response, error) {
+	return c.makeRequest(ctx, operation, http.MethodPut, path, payload, obj, nil)
 }
 
-func (c *Client) patch(ctx context.Context, path string, payload, obj interface{}) (*http.Response, error) {
-	return c.makeRequest(ctx, http.MethodPatch, path, payload, obj, nil)
+func (c *Client) patch(ctx context.Context, operation, path string, payload, obj interface{}) (*http.Response, error) {
+	return c.makeRequest(ctx, operation, http.MethodPatch, path, payload, obj, nil)
 }
 
-func (c *Client) delete(ctx context.Context, path string, payload, obj interface{}) (*http.Response, error) {
-	return c.makeRequest(ctx, http.MethodDelete, path, payload, obj, nil)
+func (c *Client) delete(ctx context.Context, operation, path string, payload, obj interface{}) (*http.Response, error) {
+	return c.makeRequest(ctx, operation, http.MethodDelete, path, payload, obj, nil)
 }
 
 // Request executes an API request with the current client scope, and returns the response.
 func (c *Client) Request(ctx context.Context, method, path string, payload, obj interface{}, headers http.Header) (*http.Response, error) {
-	return c.makeRequest(ctx, method, path, payload, obj, headers)
+	return c.makeRequest(ctx, "", method, path, payload, obj, headers)
 }
 
 // makeRequest executes an API request and returns the HTTP response.
 //
 // The content pointed by payload is serialized and used as body of the request.
 // The HTTP response is JSON decoded and stored in the value pointed by obj.
-func (c *Client) makeRequest(ctx context.Context, method, path string, payload, obj interface{}, headers http.Header) (*http.Response, error) {
+// The request goes through the client middleware chain, as the given operation.
+func (c *Client) makeRequest(ctx context.Context, operation, method, path string, payload, obj interface{}, headers http.Header) (*http.Response, error) {
+	if c.configErr != nil {
+		return nil, c.configErr
+	}
+
 	req, err := c.newRequestWithHeaders(method, path, payload, headers)
 	if err != nil {
 		return nil, err
 	}
 
-	if c.Debug {
-		log.Printf("Request (%v): %#v", req.URL, req)
+	call := &Call{
+		Operation: operation,
+		AccountID: accountFromPath(path),
+		Request:   req,
+		Result:    obj,
 	}
-
-	resp, err := c.request(ctx, req, obj)
+	resp, err := c.handler()(ctx, call)
 	if err != nil {
 		return nil, err
 	}
 
-	if c.Debug {
-		log.Printf("Response: %#v", resp)
-	}
-
 	return resp, nil
 }
 
@@ -205,12 +256,17 @@ func (c *Client) newRequest(method, path string, payload interface{}) (*http.Req
 func (c *Client) newRequestWithHeaders(method, path string, payload interface{}, headers http.Header) (*http.Request, error) {
 	url := c.BaseURL + path
 
-	body := new(bytes.Buffer)
+	// Requests without payload have no body, rather than an empty one.
+	var body io.Reader = http.NoBody
 	if payload != nil {
-		err := json.NewEncoder(body).Encode(payload)
+		// The buffer is owned by the request, as the transport may read it after the response,
+		// and sends it again when the request is retried: it can't come from a pool.
+		buf := &bytes.Buffer{}
+		err := json.NewEncoder(buf).Encode(payload)
 		if err != nil {
 			return nil, err
 		}
+		body = bytes.NewReader(buf.Bytes())
 	}
 
 	req, err := http.NewRequest(method, url, body)
@@ -249,31 +305,45 @@ func (c *Client) request(ctx context.Context, req *http.Request, obj interface{}
 	}
 	req = req.WithContext(ctx)
 
-	resp, err := c.httpClient.Do(req)
+	start := time.Now()
+	resp, err := c.do(ctx, req)
 	if err != nil {
+		c.logRequest(ctx, req, nil, err, time.Since(start), nil)
 		return nil, err
 	}
 	defer resp.Body.Close()
+	duration := time.Since(start)
+
+	// Buffer the body only when it's going to be logged.
+	var body []byte
+	if logger := c.logger(); logger != nil && logger.Enabled(ctx, LogLevelTrace) {
+		body, err = io.ReadAll(resp.Body)
+		if err != nil {
+			return resp, err
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+	}
 
 	err = CheckResponse(resp)
+	c.logRequest(ctx, req, resp, err, duration, body)
 	if err != nil {
 		return resp, err
 	}
 
 	// If obj implements the io.Writer,
-	// the response body is decoded into v.
+	// the response body is written to obj, otherwise it's decoded into obj.
 	if obj != nil {
 		if w, ok := obj.(io.Writer); ok {
 			_, err = io.Copy(w, resp.Body)
+		} else if body != nil {
+			err = c.decode(ctx, req, body, obj)
 		} else {
-			var raw []byte
-			raw, err = io.ReadAll(resp.Body)
-			if err == nil {
-				if len(raw) == 0 {
-					// Ignore empty body as temporary workaround for server sending Content-Type: application/json with an empty body.
-				} else {
-					err = json.Unmarshal(raw, obj)
-				}
+			// The body is read into a pooled buffer, that is reused across requests:
+			// json.Decoder would read it into a buffer of its own anyway, allocated for every response.
+			buf := getBuffer()
+			defer putBuffer(buf)
+			if _, err = buf.ReadFrom(resp.Body); err == nil {
+				err = c.decode(ctx, req, buf.Bytes(), obj)
 			}
 		}
 	}
@@ -281,6 +351,23 @@ func (c *Client) request(ctx context.Context, req *http.Request, obj interface{}
 	return resp, err
 }
 
+// decode decodes the JSON response body into obj, and reports its unknown fields.
+// The decoded values don't reference data, so that its buffer can be reused.
+//
+// The strict decoding reports all the fields that obj doesn't decode, rather than failing
+// on the first one like json.Decoder.DisallowUnknownFields: it needs the whole body.
+func (c *Client) decode(ctx context.Context, req *http.Request, data []byte, obj interface{}) error {
+	if len(data) == 0 {
+		// Ignore empty body as temporary workaround for server sending Content-Type: application/json with an empty body.
+		return nil
+	}
+	if err := json.Unmarshal(data, obj); err != nil {
+		return err
+	}
+	c.reportUnknownFields(ctx, req, data, obj)
+	return nil
+}
+
 // A Response represents an API response.
 type Response struct {
 	// HTTP response
@@ -325,6 +412,13 @@ type ErrorResponse struct {
 
 	// detailed validation errors
 	AttributeErrors map[string][]string `json:"errors"`
+
+	// RequestID is the value of the X-Request-Id response header,
+	// that identifies the request when contacting DNSimple support.
+	RequestID string `json:"-"`
+
+	// Body is the raw response body.
+	Body []byte `json:"-"`
 }
 
 // An alternate type of ErrorResponse, used internally.
@@ -345,31 +439,13 @@ func (r *ErrorResponse) Error() string {
 
 // tryParseBatchChangeError attempts to parse the batch change zone records error format
 func tryParseBatchChangeError(resp *http.Response, bodyBytes []byte) error {
-	type batchOperationError struct {
-		Index   int                 `json:"index"`
-		Message string              `json:"message"`
-		Errors  map[string][]string `json:"errors,omitempty"`
-	}
-
-	type batchChangeZoneRecordsErrors struct {
-		Creates []batchOperationError `json:"creates,omitempty"`
-		Updates []batchOperationError `json:"updates,omitempty"`
-		Deletes []batchOperationError `json:"deletes,omitempty"`
-	}
-
-	type batchChangeZoneRecordsErrorResponse struct {
-		Message string                       `json:"message"`
-		Errors  batchChangeZoneRecordsErrors `json:"errors"`
-	}
-
 	var batchError batchChangeZoneRecordsErrorResponse
 	if err := json.Unmarshal(bodyBytes, &batchError); err != nil {
 		return nil // Not batch error format
 	}
 
 	// Convert to standard ErrorResponse format for consistency
-	errorResponse := &ErrorResponse{}
-	errorResponse.HTTPResponse = resp
+	errorResponse := newErrorResponse(resp, bodyBytes)
 	errorResponse.Message = batchError.Message
 	errorResponse.AttributeErrors = make(map[string][]string)
 
@@ -405,6 +481,15 @@ func tryParseBatchChangeError(resp *http.Response, bodyBytes []byte) error {
 	return errorResponse
 }
 
+// newErrorResponse returns an ErrorResponse for the response and its raw body.
+func newErrorResponse(resp *http.Response, body []byte) *ErrorResponse {
+	errorResponse := &ErrorResponse{}
+	errorResponse.HTTPResponse = resp
+	errorResponse.RequestID = resp.Header.Get("X-Request-Id")
+	errorResponse.Body = body
+	return errorResponse
+}
+
 // CheckResponse checks the API response for errors, and returns them if present.
 // A response is considered an error if the status code is different than 2xx. Specific requests
 // may have additional requirements, but this is sufficient in most of the cases.
@@ -413,27 +498,35 @@ func CheckResponse(resp *http.Response) error {
 		return nil
 	}
 
+	// The body is read once: the error formats are decoded from the same bytes,
+	// that are also kept in the ErrorResponse and left readable in resp.Body.
 	bodyBytes, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return err
 	}
-	resp.Body = io.NopCloser(bytes.NewBuffer(bodyBytes))
+	resp.Body = io.NopCloser(bytes.NewReader(bodyBytes))
 
-	errorResponse := &ErrorResponse{}
-	errorResponse.HTTPResponse = resp
-	err = json.NewDecoder(resp.Body).Decode(errorResponse)
+	errorResponse := newErrorResponse(resp, bodyBytes)
+	if len(bodyBytes) == 0 {
+		return errorResponse
+	}
+	err = json.Unmarshal(bodyBytes, errorResponse)
 	if err == nil {
 		return errorResponse
 	}
 
-	// Handle the case where the errors field is a map of strings
-	var typeErr *json.UnmarshalTypeError
-	if errors.As(err, &typeErr) && typeErr.Field == "errors" {
-		resp.Body = io.NopCloser(bytes.NewBuffer(bodyBytes))
+	// The body is not JSON (e.g. an error page from a proxy):
+	// the status code is all we know about the error.
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		return errorResponse
+	}
 
+	// Handle the case where the errors field is a map of strings
+	if isErrorsFieldTypeError(err) {
 		alternateResponse := &internalAltErrorResponse{}
 
-		if jsonErr := json.NewDecoder(resp.Body).Decode(alternateResponse); jsonErr == nil {
+		if jsonErr := json.Unmarshal(bodyBytes, alternateResponse); jsonErr == nil {
 			errorResponse.Message = alternateResponse.Message
 			errorResponse.AttributeErrors = make(map[string][]string)
 			for k, v := range alternateResponse.AttributeErrors {
@@ -444,7 +537,6 @@ func CheckResponse(resp *http.Response) error {
 		}
 
 		// Try to parse as batch change zone records error format
-		resp.Body = io.NopCloser(bytes.NewBuffer(bodyBytes))
 		if batchErr := tryParseBatchChangeError(resp, bodyBytes); batchErr != nil {
 			return batchErr
 		}
@@ -452,6 +544,16 @@ func CheckResponse(resp *http.Response) error {
 	return fmt.Errorf("Error parsing error response: %w", err)
 }
 
+// isErrorsFieldTypeError reports whether err is a JSON type error of the errors field.
+// The field is "errors", or its full path such as "errors.name" since Go 1.24.
+func isErrorsFieldTypeError(err error) bool {
+	var typeErr *json.UnmarshalTypeError
+	if !errors.As(err, &typeErr) {
+		return false
+	}
+	return typeErr.Field == "errors" || strings.HasPrefix(typeErr.Field, "errors.")
+}
+
 // addOptions adds the parameters in opt as URL query parameters to s.  opt
 // must be a struct whose fields may contain "url" tags.
 func addURLQueryOptions(path string, options interface{}) (string, error) {
@@ -15,6 +15,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -138,6 +139,53 @@ func TestClient_NewRequest_CustomUserAgent(t *testing.T) {
 	assert.Equal(t, fmt.Sprintf("AwesomeClient %s", defaultUserAgent), req.Header.Get("User-Agent"))
 }
 
+func TestClient_NewRequest_NoPayload(t *testing.T) {
+	c := NewClient(http.DefaultClient)
+
+	req, err := c.newRequest("GET", "/foo", nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.NoBody, req.Body)
+	assert.Equal(t, int64(0), req.ContentLength)
+}
+
+func TestClient_NewRequest_Payload(t *testing.T) {
+	c := NewClient(http.DefaultClient)
+
+	req, err := c.newRequest("POST", "/foo", map[string]string{"name": "example.com"})
+	require.NoError(t, err)
+	// Encoding another payload doesn't change the body of the first request.
+	_, err = c.newRequest("POST", "/foo", map[string]string{"name": "example.org"})
+	require.NoError(t, err)
+
+	body, err := io.ReadAll(req.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "{\"name\":\"example.com\"}\n", string(body))
+	assert.Equal(t, int64(len(body)), req.ContentLength)
+
+	// The body can be read again, to retry the request.
+	rc, err := req.GetBody()
+	require.NoError(t, err)
+	again, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, body, again)
+}
+
+func TestClient_Request_EmptyBody(t *testing.T) {
+	setupMockServer()
+	defer teardownMockServer()
+
+	mux.HandleFunc("/v2/1010/zones/example.com", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	zoneResponse, err := client.Zones.GetZone(context.Background(), "1010", "example.com")
+
+	require.NoError(t, err)
+	assert.Nil(t, zoneResponse.Data)
+}
+
 type badObject struct{}
 
 func (o *badObject) MarshalJSON() ([]byte, error) {
@@ -166,7 +214,7 @@ func TestClient_NotFound(t *testing.T) {
 		_, _ = io.Copy(w, httpResponse.Body)
 	})
 
-	_, err := client.makeRequest(context.Background(), "POST", "/", nil, nil, nil)
+	_, err := client.makeRequest(context.Background(), "", "POST", "/", nil, nil, nil)
 
 	var got *ErrorResponse
 	assert.ErrorAs(t, err, &got)
@@ -184,7 +232,7 @@ func TestClient_ValidationError(t *testing.T) {
 		_, _ = io.Copy(w, httpResponse.Body)
 	})
 
-	_, err := client.makeRequest(context.Background(), "POST", "/", nil, nil, nil)
+	_, err := client.makeRequest(context.Background(), "", "POST", "/", nil, nil, nil)
 
 	var got *ErrorResponse
 	assert.ErrorAs(t, err, &got)
@@ -0,0 +1,2243 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package dnsimplemock
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/url"
+	"sync"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"golang.org/x/oauth2"
+)
+
+// IdentityAPI is a fake dnsimple.IdentityAPI.
+// Each method calls the function of the matching field, and records the call.
+// When the field is nil, the method returns ErrNotImplemented.
+type IdentityAPI struct {
+	WhoamiFunc func(ctx context.Context) (*dnsimple.WhoamiResponse, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Calls returns the calls made to the fake, in order.
+func (m *IdentityAPI) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+// Whoami implements dnsimple.IdentityAPI.
+func (m *IdentityAPI) Whoami(ctx context.Context) (*dnsimple.WhoamiResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "Whoami", Args: []interface{}{ctx}})
+	fn := m.WhoamiFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: IdentityAPI.Whoami", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx)
+}
+
+// AccountsAPI is a fake dnsimple.AccountsAPI.
+// Each method calls the function of the matching field, and records the call.
+// When the field is nil, the method returns ErrNotImplemented.
+type AccountsAPI struct {
+	ListAccountsFunc     func(ctx context.Context, options *dnsimple.ListOptions) (*dnsimple.AccountsResponse, error)
+	ListAccountsIterFunc func(ctx context.Context, options *dnsimple.ListOptions) iter.Seq2[dnsimple.Account, error]
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Calls returns the calls made to the fake, in order.
+func (m *AccountsAPI) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+// ListAccounts implements dnsimple.AccountsAPI.
+func (m *AccountsAPI) ListAccounts(ctx context.Context, options *dnsimple.ListOptions) (*dnsimple.AccountsResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ListAccounts", Args: []interface{}{ctx, options}})
+	fn := m.ListAccountsFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: AccountsAPI.ListAccounts", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, options)
+}
+
+// ListAccountsIter implements dnsimple.AccountsAPI.
+func (m *AccountsAPI) ListAccountsIter(ctx context.Context, options *dnsimple.ListOptions) iter.Seq2[dnsimple.Account, error] {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ListAccountsIter", Args: []interface{}{ctx, options}})
+	fn := m.ListAccountsIterFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: AccountsAPI.ListAccountsIter", ErrNotImplemented)
+		return func(yield func(dnsimple.Account, error) bool) {
+			var zero dnsimple.Account
+			yield(zero, err)
+		}
+	}
+	return fn(ctx, options)
+}
+
+// BillingAPI is a fake dnsimple.BillingAPI.
+// Each method calls the function of the matching field, and records the call.
+// When the field is nil, the method returns ErrNotImplemented.
+type BillingAPI struct {
+	ListChargesFunc     func(ctx context.Context, account string, options dnsimple.ListChargesOptions) (*dnsimple.ListChargesResponse, error)
+	ListChargesIterFunc func(ctx context.Context, account string, options dnsimple.ListChargesOptions) iter.Seq2[dnsimple.Charge, error]
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Calls returns the calls made to the fake, in order.
+func (m *BillingAPI) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+// ListCharges implements dnsimple.BillingAPI.
+func (m *BillingAPI) ListCharges(ctx context.Context, account string, options dnsimple.ListChargesOptions) (*dnsimple.ListChargesResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ListCharges", Args: []interface{}{ctx, account, options}})
+	fn := m.ListChargesFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: BillingAPI.ListCharges", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, account, options)
+}
+
+// ListChargesIter implements dnsimple.BillingAPI.
+func (m *BillingAPI) ListChargesIter(ctx context.Context, account string, options dnsimple.ListChargesOptions) iter.Seq2[dnsimple.Charge, error] {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ListChargesIter", Args: []interface{}{ctx, account, options}})
+	fn := m.ListChargesIterFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: BillingAPI.ListChargesIter", ErrNotImplemented)
+		return func(yield func(dnsimple.Charge, error) bool) {
+			var zero dnsimple.Charge
+			yield(zero, err)
+		}
+	}
+	return fn(ctx, account, options)
+}
+
+// CertificatesAPI is a fake dnsimple.CertificatesAPI.
+// Each method calls the function of the matching field, and records the call.
+// When the field is nil, the method returns ErrNotImplemented.
+type CertificatesAPI struct {
+	ListCertificatesFunc                      func(ctx context.Context, accountID, domainIdentifier string, options *dnsimple.ListOptions) (*dnsimple.CertificatesResponse, error)
+	ListCertificatesIterFunc                  func(ctx context.Context, accountID string, domainIdentifier string, options *dnsimple.ListOptions) iter.Seq2[dnsimple.Certificate, error]
+	GetCertificateFunc                        func(ctx context.Context, accountID, domainIdentifier string, certificateID int64) (*dnsimple.CertificateResponse, error)
+	DownloadCertificateFunc                   func(ctx context.Context, accountID, domainIdentifier string, certificateID int64) (*dnsimple.CertificateBundleResponse, error)
+	GetCertificatePrivateKeyFunc              func(ctx context.Context, accountID, domainIdentifier string, certificateID int64) (*dnsimple.CertificateBundleResponse, error)
+	PurchaseLetsencryptCertificateFunc        func(ctx context.Context, accountID, domainIdentifier string, certificateAttributes dnsimple.LetsencryptCertificateAttributes) (*dnsimple.CertificatePurchaseResponse, error)
+	IssueLetsencryptCertificateFunc           func(ctx context.Context, accountID, domainIdentifier string, certificateID int64) (*dnsimple.CertificateResponse, error)
+	PurchaseLetsencryptCertificateRenewalFunc func(ctx context.Context, accountID, domainIdentifier string, certificateID int64, certificateAttributes dnsimple.LetsencryptCertificateAttributes) (*dnsimple.CertificateRenewalResponse, error)
+	IssueLetsencryptCertificateRenewalFunc    func(ctx context.Context, accountID, domainIdentifier string, certificateID, certificateRenewalID int64) (*dnsimple.CertificateResponse, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Calls returns the calls made to the fake, in order.
+func (m *CertificatesAPI) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+// ListCertificates implements dnsimple.CertificatesAPI.
+func (m *CertificatesAPI) ListCertificates(ctx context.Context, accountID, domainIdentifier string, options *dnsimple.ListOptions) (*dnsimple.CertificatesResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ListCertificates", Args: []interface{}{ctx, accountID, domainIdentifier, options}})
+	fn := m.ListCertificatesFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: CertificatesAPI.ListCertificates", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainIdentifier, options)
+}
+
+// ListCertificatesIter implements dnsimple.CertificatesAPI.
+func (m *CertificatesAPI) ListCertificatesIter(ctx context.Context, accountID string, domainIdentifier string, options *dnsimple.ListOptions) iter.Seq2[dnsimple.Certificate, error] {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ListCertificatesIter", Args: []interface{}{ctx, accountID, domainIdentifier, options}})
+	fn := m.ListCertificatesIterFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: CertificatesAPI.ListCertificatesIter", ErrNotImplemented)
+		return func(yield func(dnsimple.Certificate, error) bool) {
+			var zero dnsimple.Certificate
+			yield(zero, err)
+		}
+	}
+	return fn(ctx, accountID, domainIdentifier, options)
+}
+
+// GetCertificate implements dnsimple.CertificatesAPI.
+func (m *CertificatesAPI) GetCertificate(ctx context.Context, accountID, domainIdentifier string, certificateID int64) (*dnsimple.CertificateResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetCertificate", Args: []interface{}{ctx, accountID, domainIdentifier, certificateID}})
+	fn := m.GetCertificateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: CertificatesAPI.GetCertificate", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainIdentifier, certificateID)
+}
+
+// DownloadCertificate implements dnsimple.CertificatesAPI.
+func (m *CertificatesAPI) DownloadCertificate(ctx context.Context, accountID, domainIdentifier string, certificateID int64) (*dnsimple.CertificateBundleResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "DownloadCertificate", Args: []interface{}{ctx, accountID, domainIdentifier, certificateID}})
+	fn := m.DownloadCertificateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: CertificatesAPI.DownloadCertificate", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainIdentifier, certificateID)
+}
+
+// GetCertificatePrivateKey implements dnsimple.CertificatesAPI.
+func (m *CertificatesAPI) GetCertificatePrivateKey(ctx context.Context, accountID, domainIdentifier string, certificateID int64) (*dnsimple.CertificateBundleResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetCertificatePrivateKey", Args: []interface{}{ctx, accountID, domainIdentifier, certificateID}})
+	fn := m.GetCertificatePrivateKeyFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: CertificatesAPI.GetCertificatePrivateKey", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainIdentifier, certificateID)
+}
+
+// PurchaseLetsencryptCertificate implements dnsimple.CertificatesAPI.
+func (m *CertificatesAPI) PurchaseLetsencryptCertificate(ctx context.Context, accountID, domainIdentifier string, certificateAttributes dnsimple.LetsencryptCertificateAttributes) (*dnsimple.CertificatePurchaseResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "PurchaseLetsencryptCertificate", Args: []interface{}{ctx, accountID, domainIdentifier, certificateAttributes}})
+	fn := m.PurchaseLetsencryptCertificateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: CertificatesAPI.PurchaseLetsencryptCertificate", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainIdentifier, certificateAttributes)
+}
+
+// IssueLetsencryptCertificate implements dnsimple.CertificatesAPI.
+func (m *CertificatesAPI) IssueLetsencryptCertificate(ctx context.Context, accountID, domainIdentifier string, certificateID int64) (*dnsimple.CertificateResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "IssueLetsencryptCertificate", Args: []interface{}{ctx, accountID, domainIdentifier, certificateID}})
+	fn := m.IssueLetsencryptCertificateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: CertificatesAPI.IssueLetsencryptCertificate", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainIdentifier, certificateID)
+}
+
+// PurchaseLetsencryptCertificateRenewal implements dnsimple.CertificatesAPI.
+func (m *CertificatesAPI) PurchaseLetsencryptCertificateRenewal(ctx context.Context, accountID, domainIdentifier string, certificateID int64, certificateAttributes dnsimple.LetsencryptCertificateAttributes) (*dnsimple.CertificateRenewalResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "PurchaseLetsencryptCertificateRenewal", Args: []interface{}{ctx, accountID, domainIdentifier, certificateID, certificateAttributes}})
+	fn := m.PurchaseLetsencryptCertificateRenewalFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: CertificatesAPI.PurchaseLetsencryptCertificateRenewal", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainIdentifier, certificateID, certificateAttributes)
+}
+
+// IssueLetsencryptCertificateRenewal implements dnsimple.CertificatesAPI.
+func (m *CertificatesAPI) IssueLetsencryptCertificateRenewal(ctx context.Context, accountID, domainIdentifier string, certificateID, certificateRenewalID int64) (*dnsimple.CertificateResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "IssueLetsencryptCertificateRenewal", Args: []interface{}{ctx, accountID, domainIdentifier, certificateID, certificateRenewalID}})
+	fn := m.IssueLetsencryptCertificateRenewalFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: CertificatesAPI.IssueLetsencryptCertificateRenewal", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainIdentifier, certificateID, certificateRenewalID)
+}
+
+// ContactsAPI is a fake dnsimple.ContactsAPI.
+// Each method calls the function of the matching field, and records the call.
+// When the field is nil, the method returns ErrNotImplemented.
+type ContactsAPI struct {
+	ListContactsFunc     func(ctx context.Context, accountID string, options *dnsimple.ListOptions) (*dnsimple.ContactsResponse, error)
+	ListContactsIterFunc func(ctx context.Context, accountID string, options *dnsimple.ListOptions) iter.Seq2[dnsimple.Contact, error]
+	CreateContactFunc    func(ctx context.Context, accountID string, contactAttributes dnsimple.Contact) (*dnsimple.ContactResponse, error)
+	GetContactFunc       func(ctx context.Context, accountID string, contactID int64) (*dnsimple.ContactResponse, error)
+	UpdateContactFunc    func(ctx context.Context, accountID string, contactID int64, contactAttributes dnsimple.Contact) (*dnsimple.ContactResponse, error)
+	DeleteContactFunc    func(ctx context.Context, accountID string, contactID int64) (*dnsimple.ContactResponse, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Calls returns the calls made to the fake, in order.
+func (m *ContactsAPI) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+// ListContacts implements dnsimple.ContactsAPI.
+func (m *ContactsAPI) ListContacts(ctx context.Context, accountID string, options *dnsimple.ListOptions) (*dnsimple.ContactsResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ListContacts", Args: []interface{}{ctx, accountID, options}})
+	fn := m.ListContactsFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: ContactsAPI.ListContacts", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, options)
+}
+
+// ListContactsIter implements dnsimple.ContactsAPI.
+func (m *ContactsAPI) ListContactsIter(ctx context.Context, accountID string, options *dnsimple.ListOptions) iter.Seq2[dnsimple.Contact, error] {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ListContactsIter", Args: []interface{}{ctx, accountID, options}})
+	fn := m.ListContactsIterFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: ContactsAPI.ListContactsIter", ErrNotImplemented)
+		return func(yield func(dnsimple.Contact, error) bool) {
+			var zero dnsimple.Contact
+			yield(zero, err)
+		}
+	}
+	return fn(ctx, accountID, options)
+}
+
+// CreateContact implements dnsimple.ContactsAPI.
+func (m *ContactsAPI) CreateContact(ctx context.Context, accountID string, contactAttributes dnsimple.Contact) (*dnsimple.ContactResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "CreateContact", Args: []interface{}{ctx, accountID, contactAttributes}})
+	fn := m.CreateContactFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: ContactsAPI.CreateContact", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, contactAttributes)
+}
+
+// GetContact implements dnsimple.ContactsAPI.
+func (m *ContactsAPI) GetContact(ctx context.Context, accountID string, contactID int64) (*dnsimple.ContactResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetContact", Args: []interface{}{ctx, accountID, contactID}})
+	fn := m.GetContactFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: ContactsAPI.GetContact", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, contactID)
+}
+
+// UpdateContact implements dnsimple.ContactsAPI.
+func (m *ContactsAPI) UpdateContact(ctx context.Context, accountID string, contactID int64, contactAttributes dnsimple.Contact) (*dnsimple.ContactResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "UpdateContact", Args: []interface{}{ctx, accountID, contactID, contactAttributes}})
+	fn := m.UpdateContactFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: ContactsAPI.UpdateContact", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, contactID, contactAttributes)
+}
+
+// DeleteContact implements dnsimple.ContactsAPI.
+func (m *ContactsAPI) DeleteContact(ctx context.Context, accountID string, contactID int64) (*dnsimple.ContactResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "DeleteContact", Args: []interface{}{ctx, accountID, contactID}})
+	fn := m.DeleteContactFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: ContactsAPI.DeleteContact", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, contactID)
+}
+
+// DomainsAPI is a fake dnsimple.DomainsAPI.
+// Each method calls the function of the matching field, and records the call.
+// When the field is nil, the method returns ErrNotImplemented.
+type DomainsAPI struct {
+	ListDomainsFunc                     func(ctx context.Context, accountID string, options *dnsimple.DomainListOptions) (*dnsimple.DomainsResponse, error)
+	ListDomainsIterFunc                 func(ctx context.Context, accountID string, options *dnsimple.DomainListOptions) iter.Seq2[dnsimple.Domain, error]
+	CreateDomainFunc                    func(ctx context.Context, accountID string, domainAttributes dnsimple.Domain) (*dnsimple.DomainResponse, error)
+	GetDomainFunc                       func(ctx context.Context, accountID string, domainIdentifier string) (*dnsimple.DomainResponse, error)
+	DeleteDomainFunc                    func(ctx context.Context, accountID string, domainIdentifier string) (*dnsimple.DomainResponse, error)
+	GetDomainResearchStatusFunc         func(ctx context.Context, accountID string, domainName string) (*dnsimple.DomainResearchStatusResponse, error)
+	ListDelegationSignerRecordsFunc     func(ctx context.Context, accountID string, domainIdentifier string, options *dnsimple.ListOptions) (*dnsimple.DelegationSignerRecordsResponse, error)
+	ListDelegationSignerRecordsIterFunc func(ctx context.Context, accountID string, domainIdentifier string, options *dnsimple.ListOptions) iter.Seq2[dnsimple.DelegationSignerRecord, error]
+	CreateDelegationSignerRecordFunc    func(ctx context.Context, accountID string, domainIdentifier string, dsRecordAttributes dnsimple.DelegationSignerRecord) (*dnsimple.DelegationSignerRecordResponse, error)
+	GetDelegationSignerRecordFunc       func(ctx context.Context, accountID string, domainIdentifier string, dsRecordID int64) (*dnsimple.DelegationSignerRecordResponse, error)
+	DeleteDelegationSignerRecordFunc    func(ctx context.Context, accountID string, domainIdentifier string, dsRecordID int64) (*dnsimple.DelegationSignerRecordResponse, error)
+	EnableDnssecFunc                    func(ctx context.Context, accountID string, domainIdentifier string) (*dnsimple.DnssecResponse, error)
+	DisableDnssecFunc                   func(ctx context.Context, accountID string, domainIdentifier string) (*dnsimple.DnssecResponse, error)
+	GetDnssecFunc                       func(ctx context.Context, accountID string, domainIdentifier string) (*dnsimple.DnssecResponse, error)
+	ListEmailForwardsFunc               func(ctx context.Context, accountID string, domainIdentifier string, options *dnsimple.ListOptions) (*dnsimple.EmailForwardsResponse, error)
+	ListEmailForwardsIterFunc           func(ctx context.Context, accountID string, domainIdentifier string, options *dnsimple.ListOptions) iter.Seq2[dnsimple.EmailForward, error]
+	CreateEmailForwardFunc              func(ctx context.Context, accountID string, domainIdentifier string, forwardAttributes dnsimple.EmailForward) (*dnsimple.EmailForwardResponse, error)
+	GetEmailForwardFunc                 func(ctx context.Context, accountID string, domainIdentifier string, forwardID int64) (*dnsimple.EmailForwardResponse, error)
+	DeleteEmailForwardFunc              func(ctx context.Context, accountID string, domainIdentifier string, forwardID int64) (*dnsimple.EmailForwardResponse, error)
+	InitiatePushFunc                    func(ctx context.Context, accountID, domainID string, pushAttributes dnsimple.DomainPushAttributes) (*dnsimple.DomainPushResponse, error)
+	ListPushesFunc                      func(ctx context.Context, accountID string, options *dnsimple.ListOptions) (*dnsimple.DomainPushesResponse, error)
+	ListPushesIterFunc                  func(ctx context.Context, accountID string, options *dnsimple.ListOptions) iter.Seq2[dnsimple.DomainPush, error]
+	AcceptPushFunc                      func(ctx context.Context, accountID string, pushID int64, pushAttributes dnsimple.DomainPushAttributes) (*dnsimple.DomainPushResponse, error)
+	RejectPushFunc                      func(ctx context.Context, accountID string, pushID int64) (*dnsimple.DomainPushResponse, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Calls returns the calls made to the fake, in order.
+func (m *DomainsAPI) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+// ListDomains implements dnsimple.DomainsAPI.
+func (m *DomainsAPI) ListDomains(ctx context.Context, accountID string, options *dnsimple.DomainListOptions) (*dnsimple.DomainsResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ListDomains", Args: []interface{}{ctx, accountID, options}})
+	fn := m.ListDomainsFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: DomainsAPI.ListDomains", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, options)
+}
+
+// ListDomainsIter implements dnsimple.DomainsAPI.
+func (m *DomainsAPI) ListDomainsIter(ctx context.Context, accountID string, options *dnsimple.DomainListOptions) iter.Seq2[dnsimple.Domain, error] {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ListDomainsIter", Args: []interface{}{ctx, accountID, options}})
+	fn := m.ListDomainsIterFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: DomainsAPI.ListDomainsIter", ErrNotImplemented)
+		return func(yield func(dnsimple.Domain, error) bool) {
+			var zero dnsimple.Domain
+			yield(zero, err)
+		}
+	}
+	return fn(ctx, accountID, options)
+}
+
+// CreateDomain implements dnsimple.DomainsAPI.
+func (m *DomainsAPI) CreateDomain(ctx context.Context, accountID string, domainAttributes dnsimple.Domain) (*dnsimple.DomainResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "CreateDomain", Args: []interface{}{ctx, accountID, domainAttributes}})
+	fn := m.CreateDomainFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: DomainsAPI.CreateDomain", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainAttributes)
+}
+
+// GetDomain implements dnsimple.DomainsAPI.
+func (m *DomainsAPI) GetDomain(ctx context.Context, accountID string, domainIdentifier string) (*dnsimple.DomainResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetDomain", Args: []interface{}{ctx, accountID, domainIdentifier}})
+	fn := m.GetDomainFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: DomainsAPI.GetDomain", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainIdentifier)
+}
+
+// DeleteDomain implements dnsimple.DomainsAPI.
+func (m *DomainsAPI) DeleteDomain(ctx context.Context, accountID string, domainIdentifier string) (*dnsimple.DomainResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "DeleteDomain", Args: []interface{}{ctx, accountID, domainIdentifier}})
+	fn := m.DeleteDomainFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: DomainsAPI.DeleteDomain", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainIdentifier)
+}
+
+// GetDomainResearchStatus implements dnsimple.DomainsAPI.
+func (m *DomainsAPI) GetDomainResearchStatus(ctx context.Context, accountID string, domainName string) (*dnsimple.DomainResearchStatusResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetDomainResearchStatus", Args: []interface{}{ctx, accountID, domainName}})
+	fn := m.GetDomainResearchStatusFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: DomainsAPI.GetDomainResearchStatus", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainName)
+}
+
+// ListDelegationSignerRecords implements dnsimple.DomainsAPI.
+func (m *DomainsAPI) ListDelegationSignerRecords(ctx context.Context, accountID string, domainIdentifier string, options *dnsimple.ListOptions) (*dnsimple.DelegationSignerRecordsResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ListDelegationSignerRecords", Args: []interface{}{ctx, accountID, domainIdentifier, options}})
+	fn := m.ListDelegationSignerRecordsFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: DomainsAPI.ListDelegationSignerRecords", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainIdentifier, options)
+}
+
+// ListDelegationSignerRecordsIter implements dnsimple.DomainsAPI.
+func (m *DomainsAPI) ListDelegationSignerRecordsIter(ctx context.Context, accountID string, domainIdentifier string, options *dnsimple.ListOptions) iter.Seq2[dnsimple.DelegationSignerRecord, error] {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ListDelegationSignerRecordsIter", Args: []interface{}{ctx, accountID, domainIdentifier, options}})
+	fn := m.ListDelegationSignerRecordsIterFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: DomainsAPI.ListDelegationSignerRecordsIter", ErrNotImplemented)
+		return func(yield func(dnsimple.DelegationSignerRecord, error) bool) {
+			var zero dnsimple.DelegationSignerRecord
+			yield(zero, err)
+		}
+	}
+	return fn(ctx, accountID, domainIdentifier, options)
+}
+
+// CreateDelegationSignerRecord implements dnsimple.DomainsAPI.
+func (m *DomainsAPI) CreateDelegationSignerRecord(ctx context.Context, accountID string, domainIdentifier string, dsRecordAttributes dnsimple.DelegationSignerRecord) (*dnsimple.DelegationSignerRecordResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "CreateDelegationSignerRecord", Args: []interface{}{ctx, accountID, domainIdentifier, dsRecordAttributes}})
+	fn := m.CreateDelegationSignerRecordFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: DomainsAPI.CreateDelegationSignerRecord", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainIdentifier, dsRecordAttributes)
+}
+
+// GetDelegationSignerRecord implements dnsimple.DomainsAPI.
+func (m *DomainsAPI) GetDelegationSignerRecord(ctx context.Context, accountID string, domainIdentifier string, dsRecordID int64) (*dnsimple.DelegationSignerRecordResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetDelegationSignerRecord", Args: []interface{}{ctx, accountID, domainIdentifier, dsRecordID}})
+	fn := m.GetDelegationSignerRecordFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: DomainsAPI.GetDelegationSignerRecord", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainIdentifier, dsRecordID)
+}
+
+// DeleteDelegationSignerRecord implements dnsimple.DomainsAPI.
+func (m *DomainsAPI) DeleteDelegationSignerRecord(ctx context.Context, accountID string, domainIdentifier string, dsRecordID int64) (*dnsimple.DelegationSignerRecordResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "DeleteDelegationSignerRecord", Args: []interface{}{ctx, accountID, domainIdentifier, dsRecordID}})
+	fn := m.DeleteDelegationSignerRecordFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: DomainsAPI.DeleteDelegationSignerRecord", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainIdentifier, dsRecordID)
+}
+
+// EnableDnssec implements dnsimple.DomainsAPI.
+func (m *DomainsAPI) EnableDnssec(ctx context.Context, accountID string, domainIdentifier string) (*dnsimple.DnssecResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "EnableDnssec", Args: []interface{}{ctx, accountID, domainIdentifier}})
+	fn := m.EnableDnssecFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: DomainsAPI.EnableDnssec", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainIdentifier)
+}
+
+// DisableDnssec implements dnsimple.DomainsAPI.
+func (m *DomainsAPI) DisableDnssec(ctx context.Context, accountID string, domainIdentifier string) (*dnsimple.DnssecResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "DisableDnssec", Args: []interface{}{ctx, accountID, domainIdentifier}})
+	fn := m.DisableDnssecFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: DomainsAPI.DisableDnssec", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainIdentifier)
+}
+
+// GetDnssec implements dnsimple.DomainsAPI.
+func (m *DomainsAPI) GetDnssec(ctx context.Context, accountID string, domainIdentifier string) (*dnsimple.DnssecResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetDnssec", Args: []interface{}{ctx, accountID, domainIdentifier}})
+	fn := m.GetDnssecFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: DomainsAPI.GetDnssec", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainIdentifier)
+}
+
+// ListEmailForwards implements dnsimple.DomainsAPI.
+func (m *DomainsAPI) ListEmailForwards(ctx context.Context, accountID string, domainIdentifier string, options *dnsimple.ListOptions) (*dnsimple.EmailForwardsResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ListEmailForwards", Args: []interface{}{ctx, accountID, domainIdentifier, options}})
+	fn := m.ListEmailForwardsFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: DomainsAPI.ListEmailForwards", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainIdentifier, options)
+}
+
+// ListEmailForwardsIter implements dnsimple.DomainsAPI.
+func (m *DomainsAPI) ListEmailForwardsIter(ctx context.Context, accountID string, domainIdentifier string, options *dnsimple.ListOptions) iter.Seq2[dnsimple.EmailForward, error] {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ListEmailForwardsIter", Args: []interface{}{ctx, accountID, domainIdentifier, options}})
+	fn := m.ListEmailForwardsIterFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: DomainsAPI.ListEmailForwardsIter", ErrNotImplemented)
+		return func(yield func(dnsimple.EmailForward, error) bool) {
+			var zero dnsimple.EmailForward
+			yield(zero, err)
+		}
+	}
+	return fn(ctx, accountID, domainIdentifier, options)
+}
+
+// CreateEmailForward implements dnsimple.DomainsAPI.
+func (m *DomainsAPI) CreateEmailForward(ctx context.Context, accountID string, domainIdentifier string, forwardAttributes dnsimple.EmailForward) (*dnsimple.EmailForwardResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "CreateEmailForward", Args: []interface{}{ctx, accountID, domainIdentifier, forwardAttributes}})
+	fn := m.CreateEmailForwardFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: DomainsAPI.CreateEmailForward", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainIdentifier, forwardAttributes)
+}
+
+// GetEmailForward implements dnsimple.DomainsAPI.
+func (m *DomainsAPI) GetEmailForward(ctx context.Context, accountID string, domainIdentifier string, forwardID int64) (*dnsimple.EmailForwardResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetEmailForward", Args: []interface{}{ctx, accountID, domainIdentifier, forwardID}})
+	fn := m.GetEmailForwardFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: DomainsAPI.GetEmailForward", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainIdentifier, forwardID)
+}
+
+// DeleteEmailForward implements dnsimple.DomainsAPI.
+func (m *DomainsAPI) DeleteEmailForward(ctx context.Context, accountID string, domainIdentifier string, forwardID int64) (*dnsimple.EmailForwardResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "DeleteEmailForward", Args: []interface{}{ctx, accountID, domainIdentifier, forwardID}})
+	fn := m.DeleteEmailForwardFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: DomainsAPI.DeleteEmailForward", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainIdentifier, forwardID)
+}
+
+// InitiatePush implements dnsimple.DomainsAPI.
+func (m *DomainsAPI) InitiatePush(ctx context.Context, accountID, domainID string, pushAttributes dnsimple.DomainPushAttributes) (*dnsimple.DomainPushResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "InitiatePush", Args: []interface{}{ctx, accountID, domainID, pushAttributes}})
+	fn := m.InitiatePushFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: DomainsAPI.InitiatePush", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainID, pushAttributes)
+}
+
+// ListPushes implements dnsimple.DomainsAPI.
+func (m *DomainsAPI) ListPushes(ctx context.Context, accountID string, options *dnsimple.ListOptions) (*dnsimple.DomainPushesResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ListPushes", Args: []interface{}{ctx, accountID, options}})
+	fn := m.ListPushesFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: DomainsAPI.ListPushes", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, options)
+}
+
+// ListPushesIter implements dnsimple.DomainsAPI.
+func (m *DomainsAPI) ListPushesIter(ctx context.Context, accountID string, options *dnsimple.ListOptions) iter.Seq2[dnsimple.DomainPush, error] {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ListPushesIter", Args: []interface{}{ctx, accountID, options}})
+	fn := m.ListPushesIterFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: DomainsAPI.ListPushesIter", ErrNotImplemented)
+		return func(yield func(dnsimple.DomainPush, error) bool) {
+			var zero dnsimple.DomainPush
+			yield(zero, err)
+		}
+	}
+	return fn(ctx, accountID, options)
+}
+
+// AcceptPush implements dnsimple.DomainsAPI.
+func (m *DomainsAPI) AcceptPush(ctx context.Context, accountID string, pushID int64, pushAttributes dnsimple.DomainPushAttributes) (*dnsimple.DomainPushResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "AcceptPush", Args: []interface{}{ctx, accountID, pushID, pushAttributes}})
+	fn := m.AcceptPushFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: DomainsAPI.AcceptPush", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, pushID, pushAttributes)
+}
+
+// RejectPush implements dnsimple.DomainsAPI.
+func (m *DomainsAPI) RejectPush(ctx context.Context, accountID string, pushID int64) (*dnsimple.DomainPushResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "RejectPush", Args: []interface{}{ctx, accountID, pushID}})
+	fn := m.RejectPushFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: DomainsAPI.RejectPush", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, pushID)
+}
+
+// DnsAnalyticsAPI is a fake dnsimple.DnsAnalyticsAPI.
+// Each method calls the function of the matching field, and records the call.
+// When the field is nil, the method returns ErrNotImplemented.
+type DnsAnalyticsAPI struct {
+	QueryFunc func(ctx context.Context, accountID int64, options *dnsimple.DnsAnalyticsOptions) (*dnsimple.DnsAnalyticsResponse, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Calls returns the calls made to the fake, in order.
+func (m *DnsAnalyticsAPI) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+// Query implements dnsimple.DnsAnalyticsAPI.
+func (m *DnsAnalyticsAPI) Query(ctx context.Context, accountID int64, options *dnsimple.DnsAnalyticsOptions) (*dnsimple.DnsAnalyticsResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "Query", Args: []interface{}{ctx, accountID, options}})
+	fn := m.QueryFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: DnsAnalyticsAPI.Query", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, options)
+}
+
+// OauthAPI is a fake dnsimple.OauthAPI.
+// Each method calls the function of the matching field, and records the call.
+// When the field is nil, the method returns ErrNotImplemented.
+type OauthAPI struct {
+	ExchangeAuthorizationForTokenFunc        func(authorization *dnsimple.ExchangeAuthorizationRequest) (*dnsimple.AccessToken, error)
+	ExchangeAuthorizationForTokenContextFunc func(ctx context.Context, authorization *dnsimple.ExchangeAuthorizationRequest) (*dnsimple.AccessToken, error)
+	AuthorizeURLFunc                         func(clientID string, options *dnsimple.AuthorizationOptions) string
+	NewFlowFunc                              func(clientID, clientSecret, redirectURI string, pkce bool) (*dnsimple.OauthFlow, error)
+	FlowAuthorizeURLFunc                     func(flow *dnsimple.OauthFlow) string
+	ExchangeRedirectFunc                     func(ctx context.Context, flow *dnsimple.OauthFlow, query url.Values) (*dnsimple.AccessToken, error)
+	NewClientFromRedirectFunc                func(ctx context.Context, flow *dnsimple.OauthFlow, query url.Values, opts ...dnsimple.Option) (*dnsimple.Client, *dnsimple.AccessToken, error)
+	ConfigFunc                               func(clientID, clientSecret, redirectURI string) *oauth2.Config
+	LoopbackLoginFunc                        func(ctx context.Context, login *dnsimple.LoopbackLogin) (*dnsimple.AccessToken, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Calls returns the calls made to the fake, in order.
+func (m *OauthAPI) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+// ExchangeAuthorizationForToken implements dnsimple.OauthAPI.
+func (m *OauthAPI) ExchangeAuthorizationForToken(authorization *dnsimple.ExchangeAuthorizationRequest) (*dnsimple.AccessToken, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ExchangeAuthorizationForToken", Args: []interface{}{authorization}})
+	fn := m.ExchangeAuthorizationForTokenFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: OauthAPI.ExchangeAuthorizationForToken", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(authorization)
+}
+
+// ExchangeAuthorizationForTokenContext implements dnsimple.OauthAPI.
+func (m *OauthAPI) ExchangeAuthorizationForTokenContext(ctx context.Context, authorization *dnsimple.ExchangeAuthorizationRequest) (*dnsimple.AccessToken, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ExchangeAuthorizationForTokenContext", Args: []interface{}{ctx, authorization}})
+	fn := m.ExchangeAuthorizationForTokenContextFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: OauthAPI.ExchangeAuthorizationForTokenContext", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, authorization)
+}
+
+// AuthorizeURL implements dnsimple.OauthAPI.
+func (m *OauthAPI) AuthorizeURL(clientID string, options *dnsimple.AuthorizationOptions) string {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "AuthorizeURL", Args: []interface{}{clientID, options}})
+	fn := m.AuthorizeURLFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: OauthAPI.AuthorizeURL", ErrNotImplemented)
+		panic(err)
+	}
+	return fn(clientID, options)
+}
+
+// NewFlow implements dnsimple.OauthAPI.
+func (m *OauthAPI) NewFlow(clientID, clientSecret, redirectURI string, pkce bool) (*dnsimple.OauthFlow, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "NewFlow", Args: []interface{}{clientID, clientSecret, redirectURI, pkce}})
+	fn := m.NewFlowFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: OauthAPI.NewFlow", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(clientID, clientSecret, redirectURI, pkce)
+}
+
+// FlowAuthorizeURL implements dnsimple.OauthAPI.
+func (m *OauthAPI) FlowAuthorizeURL(flow *dnsimple.OauthFlow) string {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "FlowAuthorizeURL", Args: []interface{}{flow}})
+	fn := m.FlowAuthorizeURLFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: OauthAPI.FlowAuthorizeURL", ErrNotImplemented)
+		panic(err)
+	}
+	return fn(flow)
+}
+
+// ExchangeRedirect implements dnsimple.OauthAPI.
+func (m *OauthAPI) ExchangeRedirect(ctx context.Context, flow *dnsimple.OauthFlow, query url.Values) (*dnsimple.AccessToken, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ExchangeRedirect", Args: []interface{}{ctx, flow, query}})
+	fn := m.ExchangeRedirectFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: OauthAPI.ExchangeRedirect", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, flow, query)
+}
+
+// NewClientFromRedirect implements dnsimple.OauthAPI.
+func (m *OauthAPI) NewClientFromRedirect(ctx context.Context, flow *dnsimple.OauthFlow, query url.Values, opts ...dnsimple.Option) (*dnsimple.Client, *dnsimple.AccessToken, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "NewClientFromRedirect", Args: []interface{}{ctx, flow, query, opts}})
+	fn := m.NewClientFromRedirectFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: OauthAPI.NewClientFromRedirect", ErrNotImplemented)
+		return nil, nil, err
+	}
+	return fn(ctx, flow, query, opts...)
+}
+
+// Config implements dnsimple.OauthAPI.
+func (m *OauthAPI) Config(clientID, clientSecret, redirectURI string) *oauth2.Config {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "Config", Args: []interface{}{clientID, clientSecret, redirectURI}})
+	fn := m.ConfigFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: OauthAPI.Config", ErrNotImplemented)
+		panic(err)
+	}
+	return fn(clientID, clientSecret, redirectURI)
+}
+
+// LoopbackLogin implements dnsimple.OauthAPI.
+func (m *OauthAPI) LoopbackLogin(ctx context.Context, login *dnsimple.LoopbackLogin) (*dnsimple.AccessToken, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "LoopbackLogin", Args: []interface{}{ctx, login}})
+	fn := m.LoopbackLoginFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: OauthAPI.LoopbackLogin", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, login)
+}
+
+// RegistrarAPI is a fake dnsimple.RegistrarAPI.
+// Each method calls the function of the matching field, and records the call.
+// When the field is nil, the method returns ErrNotImplemented.
+type RegistrarAPI struct {
+	CheckDomainFunc                      func(ctx context.Context, accountID string, domainName string) (*dnsimple.DomainCheckResponse, error)
+	GetDomainPricesFunc                  func(ctx context.Context, accountID string, domainName string) (*dnsimple.DomainPriceResponse, error)
+	GetDomainRegistrationFunc            func(ctx context.Context, accountID string, domainName string, domainRegistrationID string) (*dnsimple.DomainRegistrationResponse, error)
+	RegisterDomainFunc                   func(ctx context.Context, accountID string, domainName string, input *dnsimple.RegisterDomainInput) (*dnsimple.DomainRegistrationResponse, error)
+	TransferDomainFunc                   func(ctx context.Context, accountID string, domainName string, input *dnsimple.TransferDomainInput) (*dnsimple.DomainTransferResponse, error)
+	GetDomainTransferFunc                func(ctx context.Context, accountID string, domainName string, domainTransferID int64) (*dnsimple.DomainTransferResponse, error)
+	CancelDomainTransferFunc             func(ctx context.Context, accountID string, domainName string, domainTransferID int64) (*dnsimple.DomainTransferResponse, error)
+	TransferDomainOutFunc                func(ctx context.Context, accountID string, domainName string) (*dnsimple.DomainTransferOutResponse, error)
+	GetDomainRenewalFunc                 func(ctx context.Context, accountID string, domainName string, domainRenewalID string) (*dnsimple.DomainRenewalResponse, error)
+	RenewDomainFunc                      func(ctx context.Context, accountID string, domainName string, input *dnsimple.RenewDomainInput) (*dnsimple.DomainRenewalResponse, error)
+	RestoreDomainFunc                    func(ctx context.Context, accountID string, domainName string, input *dnsimple.RenewDomainInput) (*dnsimple.DomainRenewalResponse, error)
+	GetDomainRestoreFunc                 func(ctx context.Context, accountID string, domainName string, domainRestoreID string) (*dnsimple.DomainRestoreResponse, error)
+	EnableDomainAutoRenewalFunc          func(ctx context.Context, accountID string, domainName string) (*dnsimple.DomainResponse, error)
+	DisableDomainAutoRenewalFunc         func(ctx context.Context, accountID string, domainName string) (*dnsimple.DomainResponse, error)
+	GetDomainDelegationFunc              func(ctx context.Context, accountID string, domainName string) (*dnsimple.DelegationResponse, error)
+	ChangeDomainDelegationFunc           func(ctx context.Context, accountID string, domainName string, newDelegation *dnsimple.Delegation) (*dnsimple.DelegationResponse, error)
+	ChangeDomainDelegationToVanityFunc   func(ctx context.Context, accountID string, domainName string, newDelegation *dnsimple.Delegation) (*dnsimple.VanityDelegationResponse, error)
+	ChangeDomainDelegationFromVanityFunc func(ctx context.Context, accountID string, domainName string) (*dnsimple.VanityDelegationResponse, error)
+	GetDomainTransferLockFunc            func(ctx context.Context, accountID string, domainIdentifier string) (*dnsimple.DomainTransferLockResponse, error)
+	EnableDomainTransferLockFunc         func(ctx context.Context, accountID string, domainIdentifier string) (*dnsimple.DomainTransferLockResponse, error)
+	DisableDomainTransferLockFunc        func(ctx context.Context, accountID string, domainIdentifier string) (*dnsimple.DomainTransferLockResponse, error)
+	ListRegistrantChangeFunc             func(ctx context.Context, accountID string, options *dnsimple.RegistrantChangeListOptions) (*dnsimple.RegistrantChangesListResponse, error)
+	ListRegistrantChangeIterFunc         func(ctx context.Context, accountID string, options *dnsimple.RegistrantChangeListOptions) iter.Seq2[dnsimple.RegistrantChange, error]
+	CreateRegistrantChangeFunc           func(ctx context.Context, accountID string, input *dnsimple.CreateRegistrantChangeInput) (*dnsimple.RegistrantChangeResponse, error)
+	CheckRegistrantChangeFunc            func(ctx context.Context, accountID string, input *dnsimple.CheckRegistrantChangeInput) (*dnsimple.RegistrantChangeCheckResponse, error)
+	GetRegistrantChangeFunc              func(ctx context.Context, accountID string, registrantChange int) (*dnsimple.RegistrantChangeResponse, error)
+	DeleteRegistrantChangeFunc           func(ctx context.Context, accountID string, registrantChange int) (*dnsimple.RegistrantChangeDeleteResponse, error)
+	EnableWhoisPrivacyFunc               func(ctx context.Context, accountID string, domainName string) (*dnsimple.WhoisPrivacyResponse, error)
+	DisableWhoisPrivacyFunc              func(ctx context.Context, accountID string, domainName string) (*dnsimple.WhoisPrivacyResponse, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Calls returns the calls made to the fake, in order.
+func (m *RegistrarAPI) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+// CheckDomain implements dnsimple.RegistrarAPI.
+func (m *RegistrarAPI) CheckDomain(ctx context.Context, accountID string, domainName string) (*dnsimple.DomainCheckResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "CheckDomain", Args: []interface{}{ctx, accountID, domainName}})
+	fn := m.CheckDomainFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: RegistrarAPI.CheckDomain", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainName)
+}
+
+// GetDomainPrices implements dnsimple.RegistrarAPI.
+func (m *RegistrarAPI) GetDomainPrices(ctx context.Context, accountID string, domainName string) (*dnsimple.DomainPriceResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetDomainPrices", Args: []interface{}{ctx, accountID, domainName}})
+	fn := m.GetDomainPricesFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: RegistrarAPI.GetDomainPrices", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainName)
+}
+
+// GetDomainRegistration implements dnsimple.RegistrarAPI.
+func (m *RegistrarAPI) GetDomainRegistration(ctx context.Context, accountID string, domainName string, domainRegistrationID string) (*dnsimple.DomainRegistrationResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetDomainRegistration", Args: []interface{}{ctx, accountID, domainName, domainRegistrationID}})
+	fn := m.GetDomainRegistrationFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: RegistrarAPI.GetDomainRegistration", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainName, domainRegistrationID)
+}
+
+// RegisterDomain implements dnsimple.RegistrarAPI.
+func (m *RegistrarAPI) RegisterDomain(ctx context.Context, accountID string, domainName string, input *dnsimple.RegisterDomainInput) (*dnsimple.DomainRegistrationResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "RegisterDomain", Args: []interface{}{ctx, accountID, domainName, input}})
+	fn := m.RegisterDomainFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: RegistrarAPI.RegisterDomain", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainName, input)
+}
+
+// TransferDomain implements dnsimple.RegistrarAPI.
+func (m *RegistrarAPI) TransferDomain(ctx context.Context, accountID string, domainName string, input *dnsimple.TransferDomainInput) (*dnsimple.DomainTransferResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "TransferDomain", Args: []interface{}{ctx, accountID, domainName, input}})
+	fn := m.TransferDomainFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: RegistrarAPI.TransferDomain", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainName, input)
+}
+
+// GetDomainTransfer implements dnsimple.RegistrarAPI.
+func (m *RegistrarAPI) GetDomainTransfer(ctx context.Context, accountID string, domainName string, domainTransferID int64) (*dnsimple.DomainTransferResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetDomainTransfer", Args: []interface{}{ctx, accountID, domainName, domainTransferID}})
+	fn := m.GetDomainTransferFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: RegistrarAPI.GetDomainTransfer", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainName, domainTransferID)
+}
+
+// CancelDomainTransfer implements dnsimple.RegistrarAPI.
+func (m *RegistrarAPI) CancelDomainTransfer(ctx context.Context, accountID string, domainName string, domainTransferID int64) (*dnsimple.DomainTransferResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "CancelDomainTransfer", Args: []interface{}{ctx, accountID, domainName, domainTransferID}})
+	fn := m.CancelDomainTransferFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: RegistrarAPI.CancelDomainTransfer", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainName, domainTransferID)
+}
+
+// TransferDomainOut implements dnsimple.RegistrarAPI.
+func (m *RegistrarAPI) TransferDomainOut(ctx context.Context, accountID string, domainName string) (*dnsimple.DomainTransferOutResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "TransferDomainOut", Args: []interface{}{ctx, accountID, domainName}})
+	fn := m.TransferDomainOutFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: RegistrarAPI.TransferDomainOut", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainName)
+}
+
+// GetDomainRenewal implements dnsimple.RegistrarAPI.
+func (m *RegistrarAPI) GetDomainRenewal(ctx context.Context, accountID string, domainName string, domainRenewalID string) (*dnsimple.DomainRenewalResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetDomainRenewal", Args: []interface{}{ctx, accountID, domainName, domainRenewalID}})
+	fn := m.GetDomainRenewalFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: RegistrarAPI.GetDomainRenewal", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainName, domainRenewalID)
+}
+
+// RenewDomain implements dnsimple.RegistrarAPI.
+func (m *RegistrarAPI) RenewDomain(ctx context.Context, accountID string, domainName string, input *dnsimple.RenewDomainInput) (*dnsimple.DomainRenewalResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "RenewDomain", Args: []interface{}{ctx, accountID, domainName, input}})
+	fn := m.RenewDomainFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: RegistrarAPI.RenewDomain", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainName, input)
+}
+
+// RestoreDomain implements dnsimple.RegistrarAPI.
+func (m *RegistrarAPI) RestoreDomain(ctx context.Context, accountID string, domainName string, input *dnsimple.RenewDomainInput) (*dnsimple.DomainRenewalResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "RestoreDomain", Args: []interface{}{ctx, accountID, domainName, input}})
+	fn := m.RestoreDomainFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: RegistrarAPI.RestoreDomain", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainName, input)
+}
+
+// GetDomainRestore implements dnsimple.RegistrarAPI.
+func (m *RegistrarAPI) GetDomainRestore(ctx context.Context, accountID string, domainName string, domainRestoreID string) (*dnsimple.DomainRestoreResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetDomainRestore", Args: []interface{}{ctx, accountID, domainName, domainRestoreID}})
+	fn := m.GetDomainRestoreFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: RegistrarAPI.GetDomainRestore", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainName, domainRestoreID)
+}
+
+// EnableDomainAutoRenewal implements dnsimple.RegistrarAPI.
+func (m *RegistrarAPI) EnableDomainAutoRenewal(ctx context.Context, accountID string, domainName string) (*dnsimple.DomainResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "EnableDomainAutoRenewal", Args: []interface{}{ctx, accountID, domainName}})
+	fn := m.EnableDomainAutoRenewalFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: RegistrarAPI.EnableDomainAutoRenewal", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainName)
+}
+
+// DisableDomainAutoRenewal implements dnsimple.RegistrarAPI.
+func (m *RegistrarAPI) DisableDomainAutoRenewal(ctx context.Context, accountID string, domainName string) (*dnsimple.DomainResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "DisableDomainAutoRenewal", Args: []interface{}{ctx, accountID, domainName}})
+	fn := m.DisableDomainAutoRenewalFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: RegistrarAPI.DisableDomainAutoRenewal", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainName)
+}
+
+// GetDomainDelegation implements dnsimple.RegistrarAPI.
+func (m *RegistrarAPI) GetDomainDelegation(ctx context.Context, accountID string, domainName string) (*dnsimple.DelegationResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetDomainDelegation", Args: []interface{}{ctx, accountID, domainName}})
+	fn := m.GetDomainDelegationFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: RegistrarAPI.GetDomainDelegation", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainName)
+}
+
+// ChangeDomainDelegation implements dnsimple.RegistrarAPI.
+func (m *RegistrarAPI) ChangeDomainDelegation(ctx context.Context, accountID string, domainName string, newDelegation *dnsimple.Delegation) (*dnsimple.DelegationResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ChangeDomainDelegation", Args: []interface{}{ctx, accountID, domainName, newDelegation}})
+	fn := m.ChangeDomainDelegationFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: RegistrarAPI.ChangeDomainDelegation", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainName, newDelegation)
+}
+
+// ChangeDomainDelegationToVanity implements dnsimple.RegistrarAPI.
+func (m *RegistrarAPI) ChangeDomainDelegationToVanity(ctx context.Context, accountID string, domainName string, newDelegation *dnsimple.Delegation) (*dnsimple.VanityDelegationResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ChangeDomainDelegationToVanity", Args: []interface{}{ctx, accountID, domainName, newDelegation}})
+	fn := m.ChangeDomainDelegationToVanityFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: RegistrarAPI.ChangeDomainDelegationToVanity", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainName, newDelegation)
+}
+
+// ChangeDomainDelegationFromVanity implements dnsimple.RegistrarAPI.
+func (m *RegistrarAPI) ChangeDomainDelegationFromVanity(ctx context.Context, accountID string, domainName string) (*dnsimple.VanityDelegationResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ChangeDomainDelegationFromVanity", Args: []interface{}{ctx, accountID, domainName}})
+	fn := m.ChangeDomainDelegationFromVanityFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: RegistrarAPI.ChangeDomainDelegationFromVanity", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainName)
+}
+
+// GetDomainTransferLock implements dnsimple.RegistrarAPI.
+func (m *RegistrarAPI) GetDomainTransferLock(ctx context.Context, accountID string, domainIdentifier string) (*dnsimple.DomainTransferLockResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetDomainTransferLock", Args: []interface{}{ctx, accountID, domainIdentifier}})
+	fn := m.GetDomainTransferLockFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: RegistrarAPI.GetDomainTransferLock", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainIdentifier)
+}
+
+// EnableDomainTransferLock implements dnsimple.RegistrarAPI.
+func (m *RegistrarAPI) EnableDomainTransferLock(ctx context.Context, accountID string, domainIdentifier string) (*dnsimple.DomainTransferLockResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "EnableDomainTransferLock", Args: []interface{}{ctx, accountID, domainIdentifier}})
+	fn := m.EnableDomainTransferLockFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: RegistrarAPI.EnableDomainTransferLock", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainIdentifier)
+}
+
+// DisableDomainTransferLock implements dnsimple.RegistrarAPI.
+func (m *RegistrarAPI) DisableDomainTransferLock(ctx context.Context, accountID string, domainIdentifier string) (*dnsimple.DomainTransferLockResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "DisableDomainTransferLock", Args: []interface{}{ctx, accountID, domainIdentifier}})
+	fn := m.DisableDomainTransferLockFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: RegistrarAPI.DisableDomainTransferLock", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainIdentifier)
+}
+
+// ListRegistrantChange implements dnsimple.RegistrarAPI.
+func (m *RegistrarAPI) ListRegistrantChange(ctx context.Context, accountID string, options *dnsimple.RegistrantChangeListOptions) (*dnsimple.RegistrantChangesListResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ListRegistrantChange", Args: []interface{}{ctx, accountID, options}})
+	fn := m.ListRegistrantChangeFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: RegistrarAPI.ListRegistrantChange", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, options)
+}
+
+// ListRegistrantChangeIter implements dnsimple.RegistrarAPI.
+func (m *RegistrarAPI) ListRegistrantChangeIter(ctx context.Context, accountID string, options *dnsimple.RegistrantChangeListOptions) iter.Seq2[dnsimple.RegistrantChange, error] {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ListRegistrantChangeIter", Args: []interface{}{ctx, accountID, options}})
+	fn := m.ListRegistrantChangeIterFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: RegistrarAPI.ListRegistrantChangeIter", ErrNotImplemented)
+		return func(yield func(dnsimple.RegistrantChange, error) bool) {
+			var zero dnsimple.RegistrantChange
+			yield(zero, err)
+		}
+	}
+	return fn(ctx, accountID, options)
+}
+
+// CreateRegistrantChange implements dnsimple.RegistrarAPI.
+func (m *RegistrarAPI) CreateRegistrantChange(ctx context.Context, accountID string, input *dnsimple.CreateRegistrantChangeInput) (*dnsimple.RegistrantChangeResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "CreateRegistrantChange", Args: []interface{}{ctx, accountID, input}})
+	fn := m.CreateRegistrantChangeFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: RegistrarAPI.CreateRegistrantChange", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, input)
+}
+
+// CheckRegistrantChange implements dnsimple.RegistrarAPI.
+func (m *RegistrarAPI) CheckRegistrantChange(ctx context.Context, accountID string, input *dnsimple.CheckRegistrantChangeInput) (*dnsimple.RegistrantChangeCheckResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "CheckRegistrantChange", Args: []interface{}{ctx, accountID, input}})
+	fn := m.CheckRegistrantChangeFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: RegistrarAPI.CheckRegistrantChange", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, input)
+}
+
+// GetRegistrantChange implements dnsimple.RegistrarAPI.
+func (m *RegistrarAPI) GetRegistrantChange(ctx context.Context, accountID string, registrantChange int) (*dnsimple.RegistrantChangeResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetRegistrantChange", Args: []interface{}{ctx, accountID, registrantChange}})
+	fn := m.GetRegistrantChangeFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: RegistrarAPI.GetRegistrantChange", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, registrantChange)
+}
+
+// DeleteRegistrantChange implements dnsimple.RegistrarAPI.
+func (m *RegistrarAPI) DeleteRegistrantChange(ctx context.Context, accountID string, registrantChange int) (*dnsimple.RegistrantChangeDeleteResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "DeleteRegistrantChange", Args: []interface{}{ctx, accountID, registrantChange}})
+	fn := m.DeleteRegistrantChangeFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: RegistrarAPI.DeleteRegistrantChange", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, registrantChange)
+}
+
+// EnableWhoisPrivacy implements dnsimple.RegistrarAPI.
+func (m *RegistrarAPI) EnableWhoisPrivacy(ctx context.Context, accountID string, domainName string) (*dnsimple.WhoisPrivacyResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "EnableWhoisPrivacy", Args: []interface{}{ctx, accountID, domainName}})
+	fn := m.EnableWhoisPrivacyFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: RegistrarAPI.EnableWhoisPrivacy", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainName)
+}
+
+// DisableWhoisPrivacy implements dnsimple.RegistrarAPI.
+func (m *RegistrarAPI) DisableWhoisPrivacy(ctx context.Context, accountID string, domainName string) (*dnsimple.WhoisPrivacyResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "DisableWhoisPrivacy", Args: []interface{}{ctx, accountID, domainName}})
+	fn := m.DisableWhoisPrivacyFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: RegistrarAPI.DisableWhoisPrivacy", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainName)
+}
+
+// ServicesAPI is a fake dnsimple.ServicesAPI.
+// Each method calls the function of the matching field, and records the call.
+// When the field is nil, the method returns ErrNotImplemented.
+type ServicesAPI struct {
+	ListServicesFunc        func(ctx context.Context, options *dnsimple.ListOptions) (*dnsimple.ServicesResponse, error)
+	ListServicesIterFunc    func(ctx context.Context, options *dnsimple.ListOptions) iter.Seq2[dnsimple.Service, error]
+	GetServiceFunc          func(ctx context.Context, serviceIdentifier string) (*dnsimple.ServiceResponse, error)
+	AppliedServicesFunc     func(ctx context.Context, accountID string, domainIdentifier string, options *dnsimple.ListOptions) (*dnsimple.ServicesResponse, error)
+	AppliedServicesIterFunc func(ctx context.Context, accountID string, domainIdentifier string, options *dnsimple.ListOptions) iter.Seq2[dnsimple.Service, error]
+	ApplyServiceFunc        func(ctx context.Context, accountID string, serviceIdentifier string, domainIdentifier string, settings dnsimple.DomainServiceSettings) (*dnsimple.ServiceResponse, error)
+	UnapplyServiceFunc      func(ctx context.Context, accountID string, serviceIdentifier string, domainIdentifier string) (*dnsimple.ServiceResponse, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Calls returns the calls made to the fake, in order.
+func (m *ServicesAPI) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+// ListServices implements dnsimple.ServicesAPI.
+func (m *ServicesAPI) ListServices(ctx context.Context, options *dnsimple.ListOptions) (*dnsimple.ServicesResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ListServices", Args: []interface{}{ctx, options}})
+	fn := m.ListServicesFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: ServicesAPI.ListServices", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, options)
+}
+
+// ListServicesIter implements dnsimple.ServicesAPI.
+func (m *ServicesAPI) ListServicesIter(ctx context.Context, options *dnsimple.ListOptions) iter.Seq2[dnsimple.Service, error] {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ListServicesIter", Args: []interface{}{ctx, options}})
+	fn := m.ListServicesIterFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: ServicesAPI.ListServicesIter", ErrNotImplemented)
+		return func(yield func(dnsimple.Service, error) bool) {
+			var zero dnsimple.Service
+			yield(zero, err)
+		}
+	}
+	return fn(ctx, options)
+}
+
+// GetService implements dnsimple.ServicesAPI.
+func (m *ServicesAPI) GetService(ctx context.Context, serviceIdentifier string) (*dnsimple.ServiceResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetService", Args: []interface{}{ctx, serviceIdentifier}})
+	fn := m.GetServiceFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: ServicesAPI.GetService", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, serviceIdentifier)
+}
+
+// AppliedServices implements dnsimple.ServicesAPI.
+func (m *ServicesAPI) AppliedServices(ctx context.Context, accountID string, domainIdentifier string, options *dnsimple.ListOptions) (*dnsimple.ServicesResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "AppliedServices", Args: []interface{}{ctx, accountID, domainIdentifier, options}})
+	fn := m.AppliedServicesFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: ServicesAPI.AppliedServices", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainIdentifier, options)
+}
+
+// AppliedServicesIter implements dnsimple.ServicesAPI.
+func (m *ServicesAPI) AppliedServicesIter(ctx context.Context, accountID string, domainIdentifier string, options *dnsimple.ListOptions) iter.Seq2[dnsimple.Service, error] {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "AppliedServicesIter", Args: []interface{}{ctx, accountID, domainIdentifier, options}})
+	fn := m.AppliedServicesIterFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: ServicesAPI.AppliedServicesIter", ErrNotImplemented)
+		return func(yield func(dnsimple.Service, error) bool) {
+			var zero dnsimple.Service
+			yield(zero, err)
+		}
+	}
+	return fn(ctx, accountID, domainIdentifier, options)
+}
+
+// ApplyService implements dnsimple.ServicesAPI.
+func (m *ServicesAPI) ApplyService(ctx context.Context, accountID string, serviceIdentifier string, domainIdentifier string, settings dnsimple.DomainServiceSettings) (*dnsimple.ServiceResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ApplyService", Args: []interface{}{ctx, accountID, serviceIdentifier, domainIdentifier, settings}})
+	fn := m.ApplyServiceFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: ServicesAPI.ApplyService", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, serviceIdentifier, domainIdentifier, settings)
+}
+
+// UnapplyService implements dnsimple.ServicesAPI.
+func (m *ServicesAPI) UnapplyService(ctx context.Context, accountID string, serviceIdentifier string, domainIdentifier string) (*dnsimple.ServiceResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "UnapplyService", Args: []interface{}{ctx, accountID, serviceIdentifier, domainIdentifier}})
+	fn := m.UnapplyServiceFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: ServicesAPI.UnapplyService", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, serviceIdentifier, domainIdentifier)
+}
+
+// TemplatesAPI is a fake dnsimple.TemplatesAPI.
+// Each method calls the function of the matching field, and records the call.
+// When the field is nil, the method returns ErrNotImplemented.
+type TemplatesAPI struct {
+	ListTemplatesFunc           func(ctx context.Context, accountID string, options *dnsimple.ListOptions) (*dnsimple.TemplatesResponse, error)
+	ListTemplatesIterFunc       func(ctx context.Context, accountID string, options *dnsimple.ListOptions) iter.Seq2[dnsimple.Template, error]
+	CreateTemplateFunc          func(ctx context.Context, accountID string, templateAttributes dnsimple.Template) (*dnsimple.TemplateResponse, error)
+	GetTemplateFunc             func(ctx context.Context, accountID string, templateIdentifier string) (*dnsimple.TemplateResponse, error)
+	UpdateTemplateFunc          func(ctx context.Context, accountID string, templateIdentifier string, templateAttributes dnsimple.Template) (*dnsimple.TemplateResponse, error)
+	DeleteTemplateFunc          func(ctx context.Context, accountID string, templateIdentifier string) (*dnsimple.TemplateResponse, error)
+	ApplyTemplateFunc           func(ctx context.Context, accountID string, templateIdentifier string, domainIdentifier string) (*dnsimple.TemplateResponse, error)
+	ListTemplateRecordsFunc     func(ctx context.Context, accountID string, templateIdentifier string, options *dnsimple.ListOptions) (*dnsimple.TemplateRecordsResponse, error)
+	ListTemplateRecordsIterFunc func(ctx context.Context, accountID string, templateIdentifier string, options *dnsimple.ListOptions) iter.Seq2[dnsimple.TemplateRecord, error]
+	CreateTemplateRecordFunc    func(ctx context.Context, accountID string, templateIdentifier string, templateRecordAttributes dnsimple.TemplateRecord) (*dnsimple.TemplateRecordResponse, error)
+	GetTemplateRecordFunc       func(ctx context.Context, accountID string, templateIdentifier string, templateRecordID int64) (*dnsimple.TemplateRecordResponse, error)
+	DeleteTemplateRecordFunc    func(ctx context.Context, accountID string, templateIdentifier string, templateRecordID int64) (*dnsimple.TemplateRecordResponse, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Calls returns the calls made to the fake, in order.
+func (m *TemplatesAPI) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+// ListTemplates implements dnsimple.TemplatesAPI.
+func (m *TemplatesAPI) ListTemplates(ctx context.Context, accountID string, options *dnsimple.ListOptions) (*dnsimple.TemplatesResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ListTemplates", Args: []interface{}{ctx, accountID, options}})
+	fn := m.ListTemplatesFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: TemplatesAPI.ListTemplates", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, options)
+}
+
+// ListTemplatesIter implements dnsimple.TemplatesAPI.
+func (m *TemplatesAPI) ListTemplatesIter(ctx context.Context, accountID string, options *dnsimple.ListOptions) iter.Seq2[dnsimple.Template, error] {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ListTemplatesIter", Args: []interface{}{ctx, accountID, options}})
+	fn := m.ListTemplatesIterFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: TemplatesAPI.ListTemplatesIter", ErrNotImplemented)
+		return func(yield func(dnsimple.Template, error) bool) {
+			var zero dnsimple.Template
+			yield(zero, err)
+		}
+	}
+	return fn(ctx, accountID, options)
+}
+
+// CreateTemplate implements dnsimple.TemplatesAPI.
+func (m *TemplatesAPI) CreateTemplate(ctx context.Context, accountID string, templateAttributes dnsimple.Template) (*dnsimple.TemplateResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "CreateTemplate", Args: []interface{}{ctx, accountID, templateAttributes}})
+	fn := m.CreateTemplateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: TemplatesAPI.CreateTemplate", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, templateAttributes)
+}
+
+// GetTemplate implements dnsimple.TemplatesAPI.
+func (m *TemplatesAPI) GetTemplate(ctx context.Context, accountID string, templateIdentifier string) (*dnsimple.TemplateResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetTemplate", Args: []interface{}{ctx, accountID, templateIdentifier}})
+	fn := m.GetTemplateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: TemplatesAPI.GetTemplate", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, templateIdentifier)
+}
+
+// UpdateTemplate implements dnsimple.TemplatesAPI.
+func (m *TemplatesAPI) UpdateTemplate(ctx context.Context, accountID string, templateIdentifier string, templateAttributes dnsimple.Template) (*dnsimple.TemplateResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "UpdateTemplate", Args: []interface{}{ctx, accountID, templateIdentifier, templateAttributes}})
+	fn := m.UpdateTemplateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: TemplatesAPI.UpdateTemplate", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, templateIdentifier, templateAttributes)
+}
+
+// DeleteTemplate implements dnsimple.TemplatesAPI.
+func (m *TemplatesAPI) DeleteTemplate(ctx context.Context, accountID string, templateIdentifier string) (*dnsimple.TemplateResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "DeleteTemplate", Args: []interface{}{ctx, accountID, templateIdentifier}})
+	fn := m.DeleteTemplateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: TemplatesAPI.DeleteTemplate", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, templateIdentifier)
+}
+
+// ApplyTemplate implements dnsimple.TemplatesAPI.
+func (m *TemplatesAPI) ApplyTemplate(ctx context.Context, accountID string, templateIdentifier string, domainIdentifier string) (*dnsimple.TemplateResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ApplyTemplate", Args: []interface{}{ctx, accountID, templateIdentifier, domainIdentifier}})
+	fn := m.ApplyTemplateFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: TemplatesAPI.ApplyTemplate", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, templateIdentifier, domainIdentifier)
+}
+
+// ListTemplateRecords implements dnsimple.TemplatesAPI.
+func (m *TemplatesAPI) ListTemplateRecords(ctx context.Context, accountID string, templateIdentifier string, options *dnsimple.ListOptions) (*dnsimple.TemplateRecordsResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ListTemplateRecords", Args: []interface{}{ctx, accountID, templateIdentifier, options}})
+	fn := m.ListTemplateRecordsFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: TemplatesAPI.ListTemplateRecords", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, templateIdentifier, options)
+}
+
+// ListTemplateRecordsIter implements dnsimple.TemplatesAPI.
+func (m *TemplatesAPI) ListTemplateRecordsIter(ctx context.Context, accountID string, templateIdentifier string, options *dnsimple.ListOptions) iter.Seq2[dnsimple.TemplateRecord, error] {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ListTemplateRecordsIter", Args: []interface{}{ctx, accountID, templateIdentifier, options}})
+	fn := m.ListTemplateRecordsIterFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: TemplatesAPI.ListTemplateRecordsIter", ErrNotImplemented)
+		return func(yield func(dnsimple.TemplateRecord, error) bool) {
+			var zero dnsimple.TemplateRecord
+			yield(zero, err)
+		}
+	}
+	return fn(ctx, accountID, templateIdentifier, options)
+}
+
+// CreateTemplateRecord implements dnsimple.TemplatesAPI.
+func (m *TemplatesAPI) CreateTemplateRecord(ctx context.Context, accountID string, templateIdentifier string, templateRecordAttributes dnsimple.TemplateRecord) (*dnsimple.TemplateRecordResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "CreateTemplateRecord", Args: []interface{}{ctx, accountID, templateIdentifier, templateRecordAttributes}})
+	fn := m.CreateTemplateRecordFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: TemplatesAPI.CreateTemplateRecord", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, templateIdentifier, templateRecordAttributes)
+}
+
+// GetTemplateRecord implements dnsimple.TemplatesAPI.
+func (m *TemplatesAPI) GetTemplateRecord(ctx context.Context, accountID string, templateIdentifier string, templateRecordID int64) (*dnsimple.TemplateRecordResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetTemplateRecord", Args: []interface{}{ctx, accountID, templateIdentifier, templateRecordID}})
+	fn := m.GetTemplateRecordFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: TemplatesAPI.GetTemplateRecord", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, templateIdentifier, templateRecordID)
+}
+
+// DeleteTemplateRecord implements dnsimple.TemplatesAPI.
+func (m *TemplatesAPI) DeleteTemplateRecord(ctx context.Context, accountID string, templateIdentifier string, templateRecordID int64) (*dnsimple.TemplateRecordResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "DeleteTemplateRecord", Args: []interface{}{ctx, accountID, templateIdentifier, templateRecordID}})
+	fn := m.DeleteTemplateRecordFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: TemplatesAPI.DeleteTemplateRecord", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, templateIdentifier, templateRecordID)
+}
+
+// TldsAPI is a fake dnsimple.TldsAPI.
+// Each method calls the function of the matching field, and records the call.
+// When the field is nil, the method returns ErrNotImplemented.
+type TldsAPI struct {
+	ListTldsFunc                 func(ctx context.Context, options *dnsimple.ListOptions) (*dnsimple.TldsResponse, error)
+	ListTldsIterFunc             func(ctx context.Context, options *dnsimple.ListOptions) iter.Seq2[dnsimple.Tld, error]
+	GetTldFunc                   func(ctx context.Context, tld string) (*dnsimple.TldResponse, error)
+	GetTldExtendedAttributesFunc func(ctx context.Context, tld string) (*dnsimple.TldExtendedAttributesResponse, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Calls returns the calls made to the fake, in order.
+func (m *TldsAPI) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+// ListTlds implements dnsimple.TldsAPI.
+func (m *TldsAPI) ListTlds(ctx context.Context, options *dnsimple.ListOptions) (*dnsimple.TldsResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ListTlds", Args: []interface{}{ctx, options}})
+	fn := m.ListTldsFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: TldsAPI.ListTlds", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, options)
+}
+
+// ListTldsIter implements dnsimple.TldsAPI.
+func (m *TldsAPI) ListTldsIter(ctx context.Context, options *dnsimple.ListOptions) iter.Seq2[dnsimple.Tld, error] {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ListTldsIter", Args: []interface{}{ctx, options}})
+	fn := m.ListTldsIterFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: TldsAPI.ListTldsIter", ErrNotImplemented)
+		return func(yield func(dnsimple.Tld, error) bool) {
+			var zero dnsimple.Tld
+			yield(zero, err)
+		}
+	}
+	return fn(ctx, options)
+}
+
+// GetTld implements dnsimple.TldsAPI.
+func (m *TldsAPI) GetTld(ctx context.Context, tld string) (*dnsimple.TldResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetTld", Args: []interface{}{ctx, tld}})
+	fn := m.GetTldFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: TldsAPI.GetTld", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, tld)
+}
+
+// GetTldExtendedAttributes implements dnsimple.TldsAPI.
+func (m *TldsAPI) GetTldExtendedAttributes(ctx context.Context, tld string) (*dnsimple.TldExtendedAttributesResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetTldExtendedAttributes", Args: []interface{}{ctx, tld}})
+	fn := m.GetTldExtendedAttributesFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: TldsAPI.GetTldExtendedAttributes", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, tld)
+}
+
+// VanityNameServersAPI is a fake dnsimple.VanityNameServersAPI.
+// Each method calls the function of the matching field, and records the call.
+// When the field is nil, the method returns ErrNotImplemented.
+type VanityNameServersAPI struct {
+	EnableVanityNameServersFunc  func(ctx context.Context, accountID string, domainIdentifier string) (*dnsimple.VanityNameServerResponse, error)
+	DisableVanityNameServersFunc func(ctx context.Context, accountID string, domainIdentifier string) (*dnsimple.VanityNameServerResponse, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Calls returns the calls made to the fake, in order.
+func (m *VanityNameServersAPI) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+// EnableVanityNameServers implements dnsimple.VanityNameServersAPI.
+func (m *VanityNameServersAPI) EnableVanityNameServers(ctx context.Context, accountID string, domainIdentifier string) (*dnsimple.VanityNameServerResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "EnableVanityNameServers", Args: []interface{}{ctx, accountID, domainIdentifier}})
+	fn := m.EnableVanityNameServersFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: VanityNameServersAPI.EnableVanityNameServers", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainIdentifier)
+}
+
+// DisableVanityNameServers implements dnsimple.VanityNameServersAPI.
+func (m *VanityNameServersAPI) DisableVanityNameServers(ctx context.Context, accountID string, domainIdentifier string) (*dnsimple.VanityNameServerResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "DisableVanityNameServers", Args: []interface{}{ctx, accountID, domainIdentifier}})
+	fn := m.DisableVanityNameServersFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: VanityNameServersAPI.DisableVanityNameServers", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, domainIdentifier)
+}
+
+// WebhooksAPI is a fake dnsimple.WebhooksAPI.
+// Each method calls the function of the matching field, and records the call.
+// When the field is nil, the method returns ErrNotImplemented.
+type WebhooksAPI struct {
+	ListWebhooksFunc     func(ctx context.Context, accountID string, _ *dnsimple.ListOptions) (*dnsimple.WebhooksResponse, error)
+	ListWebhooksIterFunc func(ctx context.Context, accountID string, options *dnsimple.ListOptions) iter.Seq2[dnsimple.Webhook, error]
+	CreateWebhookFunc    func(ctx context.Context, accountID string, webhookAttributes dnsimple.Webhook) (*dnsimple.WebhookResponse, error)
+	GetWebhookFunc       func(ctx context.Context, accountID string, webhookID int64) (*dnsimple.WebhookResponse, error)
+	DeleteWebhookFunc    func(ctx context.Context, accountID string, webhookID int64) (*dnsimple.WebhookResponse, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Calls returns the calls made to the fake, in order.
+func (m *WebhooksAPI) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+// ListWebhooks implements dnsimple.WebhooksAPI.
+func (m *WebhooksAPI) ListWebhooks(ctx context.Context, accountID string, arg2 *dnsimple.ListOptions) (*dnsimple.WebhooksResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ListWebhooks", Args: []interface{}{ctx, accountID, arg2}})
+	fn := m.ListWebhooksFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: WebhooksAPI.ListWebhooks", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, arg2)
+}
+
+// ListWebhooksIter implements dnsimple.WebhooksAPI.
+func (m *WebhooksAPI) ListWebhooksIter(ctx context.Context, accountID string, options *dnsimple.ListOptions) iter.Seq2[dnsimple.Webhook, error] {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ListWebhooksIter", Args: []interface{}{ctx, accountID, options}})
+	fn := m.ListWebhooksIterFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: WebhooksAPI.ListWebhooksIter", ErrNotImplemented)
+		return func(yield func(dnsimple.Webhook, error) bool) {
+			var zero dnsimple.Webhook
+			yield(zero, err)
+		}
+	}
+	return fn(ctx, accountID, options)
+}
+
+// CreateWebhook implements dnsimple.WebhooksAPI.
+func (m *WebhooksAPI) CreateWebhook(ctx context.Context, accountID string, webhookAttributes dnsimple.Webhook) (*dnsimple.WebhookResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "CreateWebhook", Args: []interface{}{ctx, accountID, webhookAttributes}})
+	fn := m.CreateWebhookFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: WebhooksAPI.CreateWebhook", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, webhookAttributes)
+}
+
+// GetWebhook implements dnsimple.WebhooksAPI.
+func (m *WebhooksAPI) GetWebhook(ctx context.Context, accountID string, webhookID int64) (*dnsimple.WebhookResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetWebhook", Args: []interface{}{ctx, accountID, webhookID}})
+	fn := m.GetWebhookFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: WebhooksAPI.GetWebhook", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, webhookID)
+}
+
+// DeleteWebhook implements dnsimple.WebhooksAPI.
+func (m *WebhooksAPI) DeleteWebhook(ctx context.Context, accountID string, webhookID int64) (*dnsimple.WebhookResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "DeleteWebhook", Args: []interface{}{ctx, accountID, webhookID}})
+	fn := m.DeleteWebhookFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: WebhooksAPI.DeleteWebhook", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, webhookID)
+}
+
+// ZonesAPI is a fake dnsimple.ZonesAPI.
+// Each method calls the function of the matching field, and records the call.
+// When the field is nil, the method returns ErrNotImplemented.
+type ZonesAPI struct {
+	CheckZoneDistributionFunc       func(ctx context.Context, accountID string, zoneName string) (*dnsimple.ZoneDistributionResponse, error)
+	CheckZoneRecordDistributionFunc func(ctx context.Context, accountID string, zoneName string, recordID int64) (*dnsimple.ZoneDistributionResponse, error)
+	ListZonesFunc                   func(ctx context.Context, accountID string, options *dnsimple.ZoneListOptions) (*dnsimple.ZonesResponse, error)
+	ListZonesIterFunc               func(ctx context.Context, accountID string, options *dnsimple.ZoneListOptions) iter.Seq2[dnsimple.Zone, error]
+	GetZoneFunc                     func(ctx context.Context, accountID string, zoneName string) (*dnsimple.ZoneResponse, error)
+	GetZoneFileFunc                 func(ctx context.Context, accountID string, zoneName string) (*dnsimple.ZoneFileResponse, error)
+	ActivateZoneDnsFunc             func(ctx context.Context, accountID string, zoneName string) (*dnsimple.ZoneResponse, error)
+	DeactivateZoneDnsFunc           func(ctx context.Context, accountID string, zoneName string) (*dnsimple.ZoneResponse, error)
+	ListRecordsFunc                 func(ctx context.Context, accountID string, zoneName string, options *dnsimple.ZoneRecordListOptions) (*dnsimple.ZoneRecordsResponse, error)
+	ListRecordsIterFunc             func(ctx context.Context, accountID string, zoneName string, options *dnsimple.ZoneRecordListOptions) iter.Seq2[dnsimple.ZoneRecord, error]
+	CreateRecordFunc                func(ctx context.Context, accountID string, zoneName string, recordAttributes dnsimple.ZoneRecordAttributes) (*dnsimple.ZoneRecordResponse, error)
+	GetRecordFunc                   func(ctx context.Context, accountID string, zoneName string, recordID int64) (*dnsimple.ZoneRecordResponse, error)
+	UpdateRecordFunc                func(ctx context.Context, accountID string, zoneName string, recordID int64, recordAttributes dnsimple.ZoneRecordAttributes) (*dnsimple.ZoneRecordResponse, error)
+	DeleteRecordFunc                func(ctx context.Context, accountID string, zoneName string, recordID int64) (*dnsimple.ZoneRecordResponse, error)
+	BatchChangeZoneRecordsFunc      func(ctx context.Context, accountID string, zoneName string, request dnsimple.BatchChangeZoneRecordsRequest) (*dnsimple.BatchChangeZoneRecordsResponse, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Calls returns the calls made to the fake, in order.
+func (m *ZonesAPI) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+// CheckZoneDistribution implements dnsimple.ZonesAPI.
+func (m *ZonesAPI) CheckZoneDistribution(ctx context.Context, accountID string, zoneName string) (*dnsimple.ZoneDistributionResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "CheckZoneDistribution", Args: []interface{}{ctx, accountID, zoneName}})
+	fn := m.CheckZoneDistributionFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: ZonesAPI.CheckZoneDistribution", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, zoneName)
+}
+
+// CheckZoneRecordDistribution implements dnsimple.ZonesAPI.
+func (m *ZonesAPI) CheckZoneRecordDistribution(ctx context.Context, accountID string, zoneName string, recordID int64) (*dnsimple.ZoneDistributionResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "CheckZoneRecordDistribution", Args: []interface{}{ctx, accountID, zoneName, recordID}})
+	fn := m.CheckZoneRecordDistributionFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: ZonesAPI.CheckZoneRecordDistribution", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, zoneName, recordID)
+}
+
+// ListZones implements dnsimple.ZonesAPI.
+func (m *ZonesAPI) ListZones(ctx context.Context, accountID string, options *dnsimple.ZoneListOptions) (*dnsimple.ZonesResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ListZones", Args: []interface{}{ctx, accountID, options}})
+	fn := m.ListZonesFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: ZonesAPI.ListZones", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, options)
+}
+
+// ListZonesIter implements dnsimple.ZonesAPI.
+func (m *ZonesAPI) ListZonesIter(ctx context.Context, accountID string, options *dnsimple.ZoneListOptions) iter.Seq2[dnsimple.Zone, error] {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ListZonesIter", Args: []interface{}{ctx, accountID, options}})
+	fn := m.ListZonesIterFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: ZonesAPI.ListZonesIter", ErrNotImplemented)
+		return func(yield func(dnsimple.Zone, error) bool) {
+			var zero dnsimple.Zone
+			yield(zero, err)
+		}
+	}
+	return fn(ctx, accountID, options)
+}
+
+// GetZone implements dnsimple.ZonesAPI.
+func (m *ZonesAPI) GetZone(ctx context.Context, accountID string, zoneName string) (*dnsimple.ZoneResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetZone", Args: []interface{}{ctx, accountID, zoneName}})
+	fn := m.GetZoneFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: ZonesAPI.GetZone", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, zoneName)
+}
+
+// GetZoneFile implements dnsimple.ZonesAPI.
+func (m *ZonesAPI) GetZoneFile(ctx context.Context, accountID string, zoneName string) (*dnsimple.ZoneFileResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetZoneFile", Args: []interface{}{ctx, accountID, zoneName}})
+	fn := m.GetZoneFileFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: ZonesAPI.GetZoneFile", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, zoneName)
+}
+
+// ActivateZoneDns implements dnsimple.ZonesAPI.
+func (m *ZonesAPI) ActivateZoneDns(ctx context.Context, accountID string, zoneName string) (*dnsimple.ZoneResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ActivateZoneDns", Args: []interface{}{ctx, accountID, zoneName}})
+	fn := m.ActivateZoneDnsFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: ZonesAPI.ActivateZoneDns", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, zoneName)
+}
+
+// DeactivateZoneDns implements dnsimple.ZonesAPI.
+func (m *ZonesAPI) DeactivateZoneDns(ctx context.Context, accountID string, zoneName string) (*dnsimple.ZoneResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "DeactivateZoneDns", Args: []interface{}{ctx, accountID, zoneName}})
+	fn := m.DeactivateZoneDnsFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: ZonesAPI.DeactivateZoneDns", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, zoneName)
+}
+
+// ListRecords implements dnsimple.ZonesAPI.
+func (m *ZonesAPI) ListRecords(ctx context.Context, accountID string, zoneName string, options *dnsimple.ZoneRecordListOptions) (*dnsimple.ZoneRecordsResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ListRecords", Args: []interface{}{ctx, accountID, zoneName, options}})
+	fn := m.ListRecordsFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: ZonesAPI.ListRecords", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, zoneName, options)
+}
+
+// ListRecordsIter implements dnsimple.ZonesAPI.
+func (m *ZonesAPI) ListRecordsIter(ctx context.Context, accountID string, zoneName string, options *dnsimple.ZoneRecordListOptions) iter.Seq2[dnsimple.ZoneRecord, error] {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ListRecordsIter", Args: []interface{}{ctx, accountID, zoneName, options}})
+	fn := m.ListRecordsIterFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: ZonesAPI.ListRecordsIter", ErrNotImplemented)
+		return func(yield func(dnsimple.ZoneRecord, error) bool) {
+			var zero dnsimple.ZoneRecord
+			yield(zero, err)
+		}
+	}
+	return fn(ctx, accountID, zoneName, options)
+}
+
+// CreateRecord implements dnsimple.ZonesAPI.
+func (m *ZonesAPI) CreateRecord(ctx context.Context, accountID string, zoneName string, recordAttributes dnsimple.ZoneRecordAttributes) (*dnsimple.ZoneRecordResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "CreateRecord", Args: []interface{}{ctx, accountID, zoneName, recordAttributes}})
+	fn := m.CreateRecordFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: ZonesAPI.CreateRecord", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, zoneName, recordAttributes)
+}
+
+// GetRecord implements dnsimple.ZonesAPI.
+func (m *ZonesAPI) GetRecord(ctx context.Context, accountID string, zoneName string, recordID int64) (*dnsimple.ZoneRecordResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "GetRecord", Args: []interface{}{ctx, accountID, zoneName, recordID}})
+	fn := m.GetRecordFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: ZonesAPI.GetRecord", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, zoneName, recordID)
+}
+
+// UpdateRecord implements dnsimple.ZonesAPI.
+func (m *ZonesAPI) UpdateRecord(ctx context.Context, accountID string, zoneName string, recordID int64, recordAttributes dnsimple.ZoneRecordAttributes) (*dnsimple.ZoneRecordResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "UpdateRecord", Args: []interface{}{ctx, accountID, zoneName, recordID, recordAttributes}})
+	fn := m.UpdateRecordFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: ZonesAPI.UpdateRecord", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, zoneName, recordID, recordAttributes)
+}
+
+// DeleteRecord implements dnsimple.ZonesAPI.
+func (m *ZonesAPI) DeleteRecord(ctx context.Context, accountID string, zoneName string, recordID int64) (*dnsimple.ZoneRecordResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "DeleteRecord", Args: []interface{}{ctx, accountID, zoneName, recordID}})
+	fn := m.DeleteRecordFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: ZonesAPI.DeleteRecord", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, zoneName, recordID)
+}
+
+// BatchChangeZoneRecords implements dnsimple.ZonesAPI.
+func (m *ZonesAPI) BatchChangeZoneRecords(ctx context.Context, accountID string, zoneName string, request dnsimple.BatchChangeZoneRecordsRequest) (*dnsimple.BatchChangeZoneRecordsResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "BatchChangeZoneRecords", Args: []interface{}{ctx, accountID, zoneName, request}})
+	fn := m.BatchChangeZoneRecordsFunc
+	m.mu.Unlock()
+
+	if fn == nil {
+		err := fmt.Errorf("%w: ZonesAPI.BatchChangeZoneRecords", ErrNotImplemented)
+		return nil, err
+	}
+	return fn(ctx, accountID, zoneName, request)
+}
+
+var (
+	_ dnsimple.IdentityAPI          = (*IdentityAPI)(nil)
+	_ dnsimple.AccountsAPI          = (*AccountsAPI)(nil)
+	_ dnsimple.BillingAPI           = (*BillingAPI)(nil)
+	_ dnsimple.CertificatesAPI      = (*CertificatesAPI)(nil)
+	_ dnsimple.ContactsAPI          = (*ContactsAPI)(nil)
+	_ dnsimple.DomainsAPI           = (*DomainsAPI)(nil)
+	_ dnsimple.DnsAnalyticsAPI      = (*DnsAnalyticsAPI)(nil)
+	_ dnsimple.OauthAPI             = (*OauthAPI)(nil)
+	_ dnsimple.RegistrarAPI         = (*RegistrarAPI)(nil)
+	_ dnsimple.ServicesAPI          = (*ServicesAPI)(nil)
+	_ dnsimple.TemplatesAPI         = (*TemplatesAPI)(nil)
+	_ dnsimple.TldsAPI              = (*TldsAPI)(nil)
+	_ dnsimple.VanityNameServersAPI = (*VanityNameServersAPI)(nil)
+	_ dnsimple.WebhooksAPI          = (*WebhooksAPI)(nil)
+	_ dnsimple.ZonesAPI             = (*ZonesAPI)(nil)
+)
@@ -0,0 +1,68 @@
+package dnsimplemock
+
+import (
+	"context"
+	"iter"
+	"testing"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// zoneNames is an example of code that depends on an interface rather than on the client.
+func zoneNames(ctx context.Context, zones dnsimple.ZonesAPI, accountID string) ([]string, error) {
+	var names []string
+	for zone, err := range zones.ListZonesIter(ctx, accountID, nil) {
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, zone.Name)
+	}
+	return names, nil
+}
+
+func TestZonesAPI(t *testing.T) {
+	zones := &ZonesAPI{
+		GetZoneFunc: func(ctx context.Context, accountID string, zoneName string) (*dnsimple.ZoneResponse, error) {
+			return &dnsimple.ZoneResponse{Data: &dnsimple.Zone{Name: zoneName}}, nil
+		},
+	}
+
+	zoneResponse, err := zones.GetZone(context.Background(), "1010", "example.com")
+
+	require.NoError(t, err)
+	assert.Equal(t, "example.com", zoneResponse.Data.Name)
+	assert.Equal(t, []Call{{Method: "GetZone", Args: []interface{}{context.Background(), "1010", "example.com"}}}, zones.Calls())
+}
+
+func TestZonesAPI_NotImplemented(t *testing.T) {
+	zones := &ZonesAPI{}
+
+	_, err := zones.GetZone(context.Background(), "1010", "example.com")
+	assert.ErrorIs(t, err, ErrNotImplemented)
+	assert.EqualError(t, err, "dnsimplemock: method not implemented: ZonesAPI.GetZone")
+
+	_, err = zoneNames(context.Background(), zones, "1010")
+	assert.ErrorIs(t, err, ErrNotImplemented)
+
+	oauth := &OauthAPI{}
+	assert.PanicsWithError(t, "dnsimplemock: method not implemented: OauthAPI.AuthorizeURL", func() {
+		oauth.AuthorizeURL("client-id", nil)
+	})
+}
+
+func TestZonesAPI_Iter(t *testing.T) {
+	zones := &ZonesAPI{
+		ListZonesIterFunc: func(ctx context.Context, accountID string, options *dnsimple.ZoneListOptions) iter.Seq2[dnsimple.Zone, error] {
+			return func(yield func(dnsimple.Zone, error) bool) {
+				_ = yield(dnsimple.Zone{Name: "example.com"}, nil) && yield(dnsimple.Zone{Name: "example.org"}, nil)
+			}
+		},
+	}
+
+	names, err := zoneNames(context.Background(), zones, "1010")
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"example.com", "example.org"}, names)
+}
@@ -0,0 +1,31 @@
+// Package dnsimplemock provides fake implementations of the dnsimple service interfaces,
+// such as dnsimple.ZonesAPI and dnsimple.DomainsAPI, for unit tests of code that depends
+// on the interfaces rather than on *dnsimple.Client.
+//
+// Each fake has a function field per method, called by the method, and records the calls:
+//
+//	zones := &dnsimplemock.ZonesAPI{
+//		GetZoneFunc: func(ctx context.Context, accountID string, zoneName string) (*dnsimple.ZoneResponse, error) {
+//			return &dnsimple.ZoneResponse{Data: &dnsimple.Zone{Name: zoneName}}, nil
+//		},
+//	}
+//	// ... run the code under test with zones
+//	calls := zones.Calls()
+//
+// The fakes are generated from the services by the apigen command.
+package dnsimplemock
+
+import "errors"
+
+// ErrNotImplemented is returned by the methods of a fake whose function field is nil.
+// The methods that don't return an error panic with it.
+var ErrNotImplemented = errors.New("dnsimplemock: method not implemented")
+
+// Call is a call made to a fake.
+type Call struct {
+	// Method is the name of the method.
+	Method string
+
+	// Args are the arguments of the call.
+	Args []interface{}
+}
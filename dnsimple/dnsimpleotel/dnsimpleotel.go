@@ -0,0 +1,245 @@
+// Package dnsimpleotel instruments the DNSimple API client with OpenTelemetry.
+//
+// It traces every service operation with a span named after it (e.g. Zones.CreateRecord),
+// and records the latency, the errors and the rate limit headroom as metrics.
+//
+//	client := dnsimple.NewClient(tc)
+//	if err := dnsimpleotel.Instrument(client); err != nil {
+//		return err
+//	}
+//
+// The spans are children of the span in the context.Context passed to the service methods.
+//
+// The spans identify the account, zone and domain of the call. The metrics are only labelled
+// with the operation, the HTTP method and the status code, to keep their cardinality bounded.
+//
+// The package is a module of its own, so that the users of the client don't depend on OpenTelemetry.
+package dnsimpleotel
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ScopeName is the instrumentation scope name used for the tracer and the meter.
+const ScopeName = "github.com/dnsimple/dnsimple-go/v9/dnsimple/dnsimpleotel"
+
+// Attribute keys set on the spans. The operation is also set on the metrics.
+const (
+	AccountIDKey = attribute.Key("dnsimple.account_id")
+	DomainKey    = attribute.Key("dnsimple.domain")
+	OperationKey = attribute.Key("dnsimple.operation")
+	RequestIDKey = attribute.Key("dnsimple.request_id")
+	ZoneKey      = attribute.Key("dnsimple.zone")
+
+	methodKey     = attribute.Key("http.request.method")
+	statusCodeKey = attribute.Key("http.response.status_code")
+	errorTypeKey  = attribute.Key("error.type")
+	urlPathKey    = attribute.Key("url.path")
+)
+
+// config holds the instrumentation settings.
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+	propagators    propagation.TextMapPropagator
+}
+
+// Option configures the instrumentation.
+type Option func(*config)
+
+// WithTracerProvider sets the TracerProvider used to create the spans.
+// Defaults to the global TracerProvider.
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = provider
+	}
+}
+
+// WithMeterProvider sets the MeterProvider used to record the metrics.
+// Defaults to the global MeterProvider.
+func WithMeterProvider(provider metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = provider
+	}
+}
+
+// WithPropagators sets the propagators used to inject the trace context in the request headers.
+// Defaults to the global TextMapPropagator.
+func WithPropagators(propagators propagation.TextMapPropagator) Option {
+	return func(c *config) {
+		c.propagators = propagators
+	}
+}
+
+// Instrument adds the OpenTelemetry middleware to the client.
+func Instrument(client *dnsimple.Client, opts ...Option) error {
+	middleware, err := NewMiddleware(opts...)
+	if err != nil {
+		return err
+	}
+
+	client.Use(middleware)
+	return nil
+}
+
+// instruments are the metric instruments recorded by the middleware.
+type instruments struct {
+	duration  metric.Float64Histogram
+	errors    metric.Int64Counter
+	remaining metric.Int64Gauge
+	limit     metric.Int64Gauge
+}
+
+// NewMiddleware returns a dnsimple.Middleware that traces and measures the API calls.
+//
+// It records the following metrics:
+//
+//   - dnsimple.client.request.duration: the duration of the API calls, in seconds
+//   - dnsimple.client.request.errors: the number of failed API calls
+//   - dnsimple.client.rate_limit.remaining: the remaining requests in the rate limit window
+//   - dnsimple.client.rate_limit.limit: the maximum requests in the rate limit window
+func NewMiddleware(opts ...Option) (dnsimple.Middleware, error) {
+	cfg := &config{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	if cfg.tracerProvider == nil {
+		cfg.tracerProvider = otel.GetTracerProvider()
+	}
+	if cfg.meterProvider == nil {
+		cfg.meterProvider = otel.GetMeterProvider()
+	}
+	if cfg.propagators == nil {
+		cfg.propagators = otel.GetTextMapPropagator()
+	}
+
+	tracer := cfg.tracerProvider.Tracer(ScopeName, trace.WithInstrumentationVersion(dnsimple.Version))
+	meter := cfg.meterProvider.Meter(ScopeName, metric.WithInstrumentationVersion(dnsimple.Version))
+
+	var inst instruments
+	var err error
+	if inst.duration, err = meter.Float64Histogram("dnsimple.client.request.duration",
+		metric.WithDescription("Duration of the DNSimple API calls."),
+		metric.WithUnit("s")); err != nil {
+		return nil, err
+	}
+	if inst.errors, err = meter.Int64Counter("dnsimple.client.request.errors",
+		metric.WithDescription("Number of failed DNSimple API calls."),
+		metric.WithUnit("{call}")); err != nil {
+		return nil, err
+	}
+	if inst.remaining, err = meter.Int64Gauge("dnsimple.client.rate_limit.remaining",
+		metric.WithDescription("Remaining requests in the DNSimple API rate limit window."),
+		metric.WithUnit("{request}")); err != nil {
+		return nil, err
+	}
+	if inst.limit, err = meter.Int64Gauge("dnsimple.client.rate_limit.limit",
+		metric.WithDescription("Maximum requests in the DNSimple API rate limit window."),
+		metric.WithUnit("{request}")); err != nil {
+		return nil, err
+	}
+
+	return func(next dnsimple.Handler) dnsimple.Handler {
+		return func(ctx context.Context, call *dnsimple.Call) (*http.Response, error) {
+			name := call.Operation
+			if name == "" {
+				name = call.Request.Method
+			}
+
+			attrs := metricAttributes(call)
+			ctx, span := tracer.Start(ctx, name,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(attrs...),
+				trace.WithAttributes(spanAttributes(call)...))
+			defer span.End()
+
+			cfg.propagators.Inject(ctx, propagation.HeaderCarrier(call.Request.Header))
+
+			start := time.Now()
+			resp, err := next(ctx, call)
+			elapsed := time.Since(start)
+
+			if resp != nil {
+				attrs = append(attrs, statusCodeKey.Int(resp.StatusCode))
+				span.SetAttributes(statusCodeKey.Int(resp.StatusCode))
+				if requestID := resp.Header.Get("X-Request-Id"); requestID != "" {
+					span.SetAttributes(RequestIDKey.String(requestID))
+				}
+				recordRateLimit(ctx, inst, resp)
+			}
+
+			if err != nil {
+				errorType := "transport"
+				if resp != nil {
+					errorType = strconv.Itoa(resp.StatusCode)
+				}
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+				inst.errors.Add(ctx, 1, metric.WithAttributes(append(attrs, errorTypeKey.String(errorType))...))
+			}
+
+			inst.duration.Record(ctx, elapsed.Seconds(), metric.WithAttributes(attrs...))
+			return resp, err
+		}
+	}, nil
+}
+
+// metricAttributes returns the attributes of the call with a bounded set of values,
+// that are set on the spans and the metrics.
+func metricAttributes(call *dnsimple.Call) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		OperationKey.String(call.Operation),
+		methodKey.String(call.Request.Method),
+	}
+}
+
+// spanAttributes returns the attributes identifying the resources of the call, that are only set on the spans:
+// one time series per account, zone or domain would make the cardinality of the metrics unbounded.
+func spanAttributes(call *dnsimple.Call) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{urlPathKey.String(call.Request.URL.Path)}
+	if call.AccountID != "" {
+		attrs = append(attrs, AccountIDKey.String(call.AccountID))
+	}
+	if zone := pathResource(call.Request.URL.Path, "zones"); zone != "" {
+		attrs = append(attrs, ZoneKey.String(zone))
+	}
+	if domain := pathResource(call.Request.URL.Path, "domains"); domain != "" {
+		attrs = append(attrs, DomainKey.String(domain))
+	}
+	return attrs
+}
+
+// pathResource returns the identifier that follows the collection in the API path,
+// e.g. example.com for the zones collection in /v2/1010/zones/example.com/records.
+func pathResource(path, collection string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	// The first segments are the API version and the account.
+	for i := 2; i < len(segments)-1; i++ {
+		if segments[i] == collection {
+			return segments[i+1]
+		}
+	}
+	return ""
+}
+
+// recordRateLimit records the rate limit headroom reported by the response.
+func recordRateLimit(ctx context.Context, inst instruments, resp *http.Response) {
+	if remaining, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Remaining"), 10, 64); err == nil {
+		inst.remaining.Record(ctx, remaining)
+	}
+	if limit, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Limit"), 10, 64); err == nil {
+		inst.limit.Record(ctx, limit)
+	}
+}
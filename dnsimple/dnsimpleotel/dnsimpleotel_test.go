@@ -0,0 +1,138 @@
+package dnsimpleotel
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func setup(t *testing.T, handler http.HandlerFunc) (*dnsimple.Client, *tracetest.SpanRecorder, *sdkmetric.ManualReader, *sdktrace.TracerProvider) {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	recorder := tracetest.NewSpanRecorder()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	reader := sdkmetric.NewManualReader()
+	meterProvider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	client := dnsimple.NewClient(http.DefaultClient)
+	client.BaseURL = server.URL
+	require.NoError(t, Instrument(client, WithTracerProvider(tracerProvider), WithMeterProvider(meterProvider)))
+
+	return client, recorder, reader, tracerProvider
+}
+
+func collect(t *testing.T, reader *sdkmetric.ManualReader) map[string]metricdata.Metrics {
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+
+	metrics := map[string]metricdata.Metrics{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			metrics[m.Name] = m
+		}
+	}
+	return metrics
+}
+
+func TestInstrument(t *testing.T) {
+	client, recorder, reader, tracerProvider := setup(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v2/1010/zones/example.com/records", r.URL.Path)
+		w.Header().Set("X-Request-Id", "request-1")
+		w.Header().Set("X-RateLimit-Limit", "2400")
+		w.Header().Set("X-RateLimit-Remaining", "2399")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = io.WriteString(w, `{"data":{"id":1,"type":"A","name":"www","content":"127.0.0.1"}}`)
+	})
+
+	ctx, parent := tracerProvider.Tracer("test").Start(context.Background(), "parent")
+	_, err := client.Zones.CreateRecord(ctx, "1010", "example.com", dnsimple.ZoneRecordAttributes{Type: "A", Name: dnsimple.String("www"), Content: "127.0.0.1"})
+	parent.End()
+	require.NoError(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	span := spans[0]
+	assert.Equal(t, "Zones.CreateRecord", span.Name())
+	assert.Equal(t, trace.SpanKindClient, span.SpanKind())
+	assert.Equal(t, parent.SpanContext().SpanID(), span.Parent().SpanID())
+	assert.Equal(t, codes.Unset, span.Status().Code)
+	attrs := attribute.NewSet(span.Attributes()...)
+	for key, want := range map[attribute.Key]attribute.Value{
+		OperationKey:  attribute.StringValue("Zones.CreateRecord"),
+		AccountIDKey:  attribute.StringValue("1010"),
+		ZoneKey:       attribute.StringValue("example.com"),
+		RequestIDKey:  attribute.StringValue("request-1"),
+		statusCodeKey: attribute.IntValue(201),
+		methodKey:     attribute.StringValue("POST"),
+	} {
+		got, ok := attrs.Value(key)
+		assert.True(t, ok, key)
+		assert.Equal(t, want, got, key)
+	}
+
+	metrics := collect(t, reader)
+	duration := metrics["dnsimple.client.request.duration"].Data.(metricdata.Histogram[float64])
+	require.Len(t, duration.DataPoints, 1)
+	assert.Equal(t, uint64(1), duration.DataPoints[0].Count)
+	assert.ElementsMatch(t, []attribute.KeyValue{
+		OperationKey.String("Zones.CreateRecord"),
+		methodKey.String("POST"),
+		statusCodeKey.Int(201),
+	}, duration.DataPoints[0].Attributes.ToSlice())
+	remaining := metrics["dnsimple.client.rate_limit.remaining"].Data.(metricdata.Gauge[int64])
+	require.Len(t, remaining.DataPoints, 1)
+	assert.Equal(t, int64(2399), remaining.DataPoints[0].Value)
+	limit := metrics["dnsimple.client.rate_limit.limit"].Data.(metricdata.Gauge[int64])
+	assert.Equal(t, int64(2400), limit.DataPoints[0].Value)
+	assert.NotContains(t, metrics, "dnsimple.client.request.errors")
+}
+
+func TestInstrument_Error(t *testing.T) {
+	client, recorder, reader, _ := setup(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = io.WriteString(w, `{"message":"Domain `+"`"+`example.com`+"`"+` not found"}`)
+	})
+
+	_, err := client.Domains.GetDomain(context.Background(), "1010", "example.com")
+	require.Error(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "Domains.GetDomain", spans[0].Name())
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+	spanAttrs := attribute.NewSet(spans[0].Attributes()...)
+	domain, _ := spanAttrs.Value(DomainKey)
+	assert.Equal(t, "example.com", domain.AsString())
+
+	metrics := collect(t, reader)
+	errors := metrics["dnsimple.client.request.errors"].Data.(metricdata.Sum[int64])
+	require.Len(t, errors.DataPoints, 1)
+	assert.Equal(t, int64(1), errors.DataPoints[0].Value)
+	pointAttrs := errors.DataPoints[0].Attributes
+	errorType, _ := pointAttrs.Value(errorTypeKey)
+	assert.Equal(t, "404", errorType.AsString())
+	for _, key := range []attribute.Key{AccountIDKey, DomainKey, ZoneKey} {
+		assert.False(t, pointAttrs.HasValue(key), key)
+	}
+}
+
+func TestPathResource(t *testing.T) {
+	assert.Equal(t, "example.com", pathResource("/v2/1010/zones/example.com/records/1", "zones"))
+	assert.Equal(t, "example.com", pathResource("/v2/1010/registrar/domains/example.com/registrations", "domains"))
+	assert.Equal(t, "", pathResource("/v2/1010/domains", "domains"))
+	assert.Equal(t, "", pathResource("/v2/whoami", "zones"))
+}
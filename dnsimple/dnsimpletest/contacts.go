@@ -0,0 +1,96 @@
+package dnsimpletest
+
+import (
+	"net/http"
+	"slices"
+)
+
+// withContact calls h with the contact of the {contact} wildcard,
+// or writes a 404 response when it doesn't exist.
+func withContact(w http.ResponseWriter, r *http.Request, a *account, h func(contact *contactJSON, index int)) {
+	id := pathID(r, "contact")
+	contact, index := find(a.contacts, func(c *contactJSON) bool { return c.ID == id })
+	if contact == nil {
+		writeNotFound(w, "Contact", r.PathValue("contact"))
+		return
+	}
+	h(contact, index)
+}
+
+// validateContact returns the validation errors of the contact.
+func validateContact(contact *contactJSON) validationErrors {
+	errors := validationErrors{}
+	required := map[string]string{
+		"first_name":     contact.FirstName,
+		"last_name":      contact.LastName,
+		"address1":       contact.Address1,
+		"city":           contact.City,
+		"state_province": contact.StateProvince,
+		"postal_code":    contact.PostalCode,
+		"country":        contact.Country,
+		"email":          contact.Email,
+		"phone":          contact.Phone,
+	}
+	for attribute, value := range required {
+		if value == "" {
+			errors.add(attribute, "can't be blank")
+		}
+	}
+	return errors
+}
+
+func (s *Server) listContacts(w http.ResponseWriter, r *http.Request, a *account) {
+	writePage(w, r, a.contacts)
+}
+
+func (s *Server) createContact(w http.ResponseWriter, r *http.Request, a *account) {
+	var contact contactJSON
+	if !decode(w, r, &contact) {
+		return
+	}
+	if errors := validateContact(&contact); len(errors) > 0 {
+		writeValidationErrors(w, errors)
+		return
+	}
+
+	now := s.timestamp()
+	contact.ID = s.id()
+	contact.AccountID = a.account.ID
+	contact.CreatedAt, contact.UpdatedAt = now, now
+	a.contacts = append(a.contacts, &contact)
+
+	writeData(w, http.StatusCreated, &contact)
+}
+
+func (s *Server) getContact(w http.ResponseWriter, r *http.Request, a *account) {
+	withContact(w, r, a, func(contact *contactJSON, _ int) {
+		writeData(w, http.StatusOK, contact)
+	})
+}
+
+func (s *Server) updateContact(w http.ResponseWriter, r *http.Request, a *account) {
+	withContact(w, r, a, func(contact *contactJSON, index int) {
+		// The attributes missing from the request keep their value.
+		updated := *contact
+		if !decode(w, r, &updated) {
+			return
+		}
+		if errors := validateContact(&updated); len(errors) > 0 {
+			writeValidationErrors(w, errors)
+			return
+		}
+
+		updated.ID, updated.AccountID, updated.CreatedAt = contact.ID, contact.AccountID, contact.CreatedAt
+		updated.UpdatedAt = s.timestamp()
+		a.contacts[index] = &updated
+
+		writeData(w, http.StatusOK, &updated)
+	})
+}
+
+func (s *Server) deleteContact(w http.ResponseWriter, r *http.Request, a *account) {
+	withContact(w, r, a, func(_ *contactJSON, index int) {
+		a.contacts = slices.Delete(a.contacts, index, index+1)
+		writeNoContent(w)
+	})
+}
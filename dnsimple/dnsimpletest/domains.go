@@ -0,0 +1,173 @@
+package dnsimpletest
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// domain is the state of a domain.
+type domain struct {
+	domain   domainJSON
+	forwards []*emailForwardJSON
+}
+
+// findDomain returns the domain identified by its ID or name.
+func (a *account) findDomain(identifier string) (*domain, int) {
+	return find(a.domains, func(d *domain) bool {
+		return d.domain.Name == identifier || strconv.FormatInt(d.domain.ID, 10) == identifier
+	})
+}
+
+// withDomain calls h with the domain of the {domain} wildcard,
+// or writes a 404 response when it doesn't exist.
+func withDomain(w http.ResponseWriter, r *http.Request, a *account, h func(d *domain, index int)) {
+	d, index := a.findDomain(r.PathValue("domain"))
+	if d == nil {
+		writeNotFound(w, "Domain", r.PathValue("domain"))
+		return
+	}
+	h(d, index)
+}
+
+func (s *Server) listDomains(w http.ResponseWriter, r *http.Request, a *account) {
+	nameLike := r.URL.Query().Get("name_like")
+
+	domains := []domainJSON{}
+	for _, d := range a.domains {
+		if strings.Contains(d.domain.Name, nameLike) {
+			domains = append(domains, d.domain)
+		}
+	}
+	writePage(w, r, domains)
+}
+
+func (s *Server) createDomain(w http.ResponseWriter, r *http.Request, a *account) {
+	var attributes struct {
+		Name string `json:"name"`
+	}
+	if !decode(w, r, &attributes) {
+		return
+	}
+
+	name := strings.ToLower(strings.TrimSuffix(attributes.Name, "."))
+	errors := validationErrors{}
+	switch {
+	case name == "":
+		errors.add("name", "can't be blank")
+	case !strings.Contains(name, "."):
+		errors.add("name", "is an invalid domain")
+	default:
+		if d, _ := a.findDomain(name); d != nil {
+			errors.add("name", "has already been taken")
+		}
+	}
+	if len(errors) > 0 {
+		writeValidationErrors(w, errors)
+		return
+	}
+
+	now := s.timestamp()
+	d := &domain{domain: domainJSON{
+		ID:          s.id(),
+		AccountID:   a.account.ID,
+		Name:        name,
+		UnicodeName: name,
+		State:       "hosted",
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}}
+	a.domains = append(a.domains, d)
+	s.createZone(a, name)
+
+	writeData(w, http.StatusCreated, d.domain)
+}
+
+func (s *Server) getDomain(w http.ResponseWriter, r *http.Request, a *account) {
+	withDomain(w, r, a, func(d *domain, _ int) {
+		writeData(w, http.StatusOK, d.domain)
+	})
+}
+
+func (s *Server) deleteDomain(w http.ResponseWriter, r *http.Request, a *account) {
+	withDomain(w, r, a, func(d *domain, index int) {
+		a.domains = slices.Delete(a.domains, index, index+1)
+		if _, index := a.findZone(d.domain.Name); index >= 0 {
+			a.zones = slices.Delete(a.zones, index, index+1)
+		}
+		writeNoContent(w)
+	})
+}
+
+func (s *Server) listEmailForwards(w http.ResponseWriter, r *http.Request, a *account) {
+	withDomain(w, r, a, func(d *domain, _ int) {
+		writePage(w, r, d.forwards)
+	})
+}
+
+func (s *Server) createEmailForward(w http.ResponseWriter, r *http.Request, a *account) {
+	withDomain(w, r, a, func(d *domain, _ int) {
+		var attributes struct {
+			AliasName        string `json:"alias_name"`
+			DestinationEmail string `json:"destination_email"`
+		}
+		if !decode(w, r, &attributes) {
+			return
+		}
+
+		errors := validationErrors{}
+		if attributes.AliasName == "" {
+			errors.add("alias_name", "can't be blank")
+		}
+		if attributes.DestinationEmail == "" {
+			errors.add("destination_email", "can't be blank")
+		}
+		if len(errors) > 0 {
+			writeValidationErrors(w, errors)
+			return
+		}
+
+		now := s.timestamp()
+		forward := &emailForwardJSON{
+			ID:               s.id(),
+			DomainID:         d.domain.ID,
+			AliasEmail:       fmt.Sprintf("%s@%s", attributes.AliasName, d.domain.Name),
+			DestinationEmail: attributes.DestinationEmail,
+			CreatedAt:        now,
+			UpdatedAt:        now,
+			Active:           true,
+		}
+		d.forwards = append(d.forwards, forward)
+
+		writeData(w, http.StatusCreated, forward)
+	})
+}
+
+// withEmailForward calls h with the email forward of the {forward} wildcard,
+// or writes a 404 response when it doesn't exist.
+func withEmailForward(w http.ResponseWriter, r *http.Request, a *account, h func(d *domain, forward *emailForwardJSON, index int)) {
+	withDomain(w, r, a, func(d *domain, _ int) {
+		id := pathID(r, "forward")
+		forward, index := find(d.forwards, func(f *emailForwardJSON) bool { return f.ID == id })
+		if forward == nil {
+			writeNotFound(w, "Email forward", r.PathValue("forward"))
+			return
+		}
+		h(d, forward, index)
+	})
+}
+
+func (s *Server) getEmailForward(w http.ResponseWriter, r *http.Request, a *account) {
+	withEmailForward(w, r, a, func(_ *domain, forward *emailForwardJSON, _ int) {
+		writeData(w, http.StatusOK, forward)
+	})
+}
+
+func (s *Server) deleteEmailForward(w http.ResponseWriter, r *http.Request, a *account) {
+	withEmailForward(w, r, a, func(d *domain, _ *emailForwardJSON, index int) {
+		d.forwards = slices.Delete(d.forwards, index, index+1)
+		writeNoContent(w)
+	})
+}
@@ -0,0 +1,132 @@
+package dnsimpletest
+
+// FixtureRoute maps the requests that match a pattern to a fixture file. See FixtureTransport.Handle.
+type FixtureRoute struct {
+	Pattern string
+	Fixture string
+}
+
+// DefaultFixtureRoutes returns the routes of the requests of the client methods to the fixtures
+// of their successful responses, as named in the fixtures.http directory of this repository. The routes that match the same requests are ordered
+// from the least to the most specific, as expected by FixtureTransport.Handle.
+func DefaultFixtureRoutes() []FixtureRoute {
+	return []FixtureRoute{
+		{"GET /v2/whoami", "api/whoami/success.http"},
+		{"GET /v2/accounts", "api/listAccounts/success-user.http"},
+		{"POST /v2/oauth/access_token", "api/oauthAccessToken/success.http"},
+
+		{"GET /v2/{account}/billing/charges", "api/listCharges/success.http"},
+		{"GET /v2/{account}/dns_analytics", "api/dnsAnalytics/success.http"},
+
+		{"GET /v2/{account}/domains", "api/listDomains/success.http"},
+		{"POST /v2/{account}/domains", "api/createDomain/created.http"},
+		{"GET /v2/{account}/domains/{domain}", "api/getDomain/success.http"},
+		{"DELETE /v2/{account}/domains/{domain}", "api/deleteDomain/success.http"},
+		{"GET /v2/{account}/domains/research/status", "api/getDomainsResearchStatus/success-available.http"},
+
+		{"GET /v2/{account}/domains/{domain}/certificates", "api/listCertificates/success.http"},
+		{"GET /v2/{account}/domains/{domain}/certificates/{certificate}", "api/getCertificate/success.http"},
+		{"GET /v2/{account}/domains/{domain}/certificates/{certificate}/download", "api/downloadCertificate/success.http"},
+		{"GET /v2/{account}/domains/{domain}/certificates/{certificate}/private_key", "api/getCertificatePrivateKey/success.http"},
+		{"POST /v2/{account}/domains/{domain}/certificates/letsencrypt", "api/purchaseLetsencryptCertificate/success.http"},
+		{"POST /v2/{account}/domains/{domain}/certificates/letsencrypt/{certificate}/issue", "api/issueLetsencryptCertificate/success.http"},
+		{"POST /v2/{account}/domains/{domain}/certificates/letsencrypt/{certificate}/renewals", "api/purchaseRenewalLetsencryptCertificate/success.http"},
+		{"POST /v2/{account}/domains/{domain}/certificates/letsencrypt/{certificate}/renewals/{renewal}/issue", "api/issueRenewalLetsencryptCertificate/success.http"},
+
+		{"GET /v2/{account}/domains/{domain}/dnssec", "api/getDnssec/success.http"},
+		{"POST /v2/{account}/domains/{domain}/dnssec", "api/enableDnssec/success.http"},
+		{"DELETE /v2/{account}/domains/{domain}/dnssec", "api/disableDnssec/success.http"},
+		{"GET /v2/{account}/domains/{domain}/ds_records", "api/listDelegationSignerRecords/success.http"},
+		{"POST /v2/{account}/domains/{domain}/ds_records", "api/createDelegationSignerRecord/created.http"},
+		{"GET /v2/{account}/domains/{domain}/ds_records/{ds}", "api/getDelegationSignerRecord/success.http"},
+		{"DELETE /v2/{account}/domains/{domain}/ds_records/{ds}", "api/deleteDelegationSignerRecord/success.http"},
+
+		{"GET /v2/{account}/domains/{domain}/email_forwards", "api/listEmailForwards/success.http"},
+		{"POST /v2/{account}/domains/{domain}/email_forwards", "api/createEmailForward/created.http"},
+		{"GET /v2/{account}/domains/{domain}/email_forwards/{forward}", "api/getEmailForward/success.http"},
+		{"DELETE /v2/{account}/domains/{domain}/email_forwards/{forward}", "api/deleteEmailForward/success.http"},
+
+		{"POST /v2/{account}/domains/{domain}/pushes", "api/initiatePush/success.http"},
+		{"GET /v2/{account}/pushes", "api/listPushes/success.http"},
+		{"POST /v2/{account}/pushes/{push}", "api/acceptPush/success.http"},
+		{"DELETE /v2/{account}/pushes/{push}", "api/rejectPush/success.http"},
+
+		{"GET /v2/{account}/domains/{domain}/services", "api/appliedServices/success.http"},
+		{"POST /v2/{account}/domains/{domain}/services/{service}", "api/applyService/success.http"},
+		{"DELETE /v2/{account}/domains/{domain}/services/{service}", "api/unapplyService/success.http"},
+		{"GET /v2/services", "api/listServices/success.http"},
+		{"GET /v2/services/{service}", "api/getService/success.http"},
+
+		{"POST /v2/{account}/domains/{domain}/templates/{template}", "api/applyTemplate/success.http"},
+		{"GET /v2/{account}/templates", "api/listTemplates/success.http"},
+		{"POST /v2/{account}/templates", "api/createTemplate/created.http"},
+		{"GET /v2/{account}/templates/{template}", "api/getTemplate/success.http"},
+		{"PATCH /v2/{account}/templates/{template}", "api/updateTemplate/success.http"},
+		{"DELETE /v2/{account}/templates/{template}", "api/deleteTemplate/success.http"},
+		{"GET /v2/{account}/templates/{template}/records", "api/listTemplateRecords/success.http"},
+		{"POST /v2/{account}/templates/{template}/records", "api/createTemplateRecord/created.http"},
+		{"GET /v2/{account}/templates/{template}/records/{record}", "api/getTemplateRecord/success.http"},
+		{"DELETE /v2/{account}/templates/{template}/records/{record}", "api/deleteTemplateRecord/success.http"},
+
+		{"GET /v2/{account}/contacts", "api/listContacts/success.http"},
+		{"POST /v2/{account}/contacts", "api/createContact/created.http"},
+		{"GET /v2/{account}/contacts/{contact}", "api/getContact/success.http"},
+		{"PATCH /v2/{account}/contacts/{contact}", "api/updateContact/success.http"},
+		{"DELETE /v2/{account}/contacts/{contact}", "api/deleteContact/success.http"},
+
+		{"GET /v2/{account}/registrar/domains/{domain}/check", "api/checkDomain/success.http"},
+		{"GET /v2/{account}/registrar/domains/{domain}/prices", "api/getDomainPrices/success.http"},
+		{"POST /v2/{account}/registrar/domains/{domain}/registrations", "api/registerDomain/success.http"},
+		{"GET /v2/{account}/registrar/domains/{domain}/registrations/{registration}", "api/getDomainRegistration/success.http"},
+		{"POST /v2/{account}/registrar/domains/{domain}/renewals", "api/renewDomain/success.http"},
+		{"GET /v2/{account}/registrar/domains/{domain}/renewals/{renewal}", "api/getDomainRenewal/success.http"},
+		{"POST /v2/{account}/registrar/domains/{domain}/restores", "api/restoreDomain/success.http"},
+		{"GET /v2/{account}/registrar/domains/{domain}/restores/{restore}", "api/getDomainRestore/success.http"},
+		{"POST /v2/{account}/registrar/domains/{domain}/transfers", "api/transferDomain/success.http"},
+		{"GET /v2/{account}/registrar/domains/{domain}/transfers/{transfer}", "api/getDomainTransfer/success.http"},
+		{"DELETE /v2/{account}/registrar/domains/{domain}/transfers/{transfer}", "api/cancelDomainTransfer/success.http"},
+		{"POST /v2/{account}/registrar/domains/{domain}/authorize_transfer_out", "api/authorizeDomainTransferOut/success.http"},
+		{"GET /v2/{account}/registrar/domains/{domain}/delegation", "api/getDomainDelegation/success.http"},
+		{"PUT /v2/{account}/registrar/domains/{domain}/delegation", "api/changeDomainDelegation/success.http"},
+		{"PUT /v2/{account}/registrar/domains/{domain}/delegation/vanity", "api/changeDomainDelegationToVanity/success.http"},
+		{"DELETE /v2/{account}/registrar/domains/{domain}/delegation/vanity", "api/changeDomainDelegationFromVanity/success.http"},
+		{"PUT /v2/{account}/registrar/domains/{domain}/auto_renewal", "api/enableDomainAutoRenewal/success.http"},
+		{"DELETE /v2/{account}/registrar/domains/{domain}/auto_renewal", "api/disableDomainAutoRenewal/success.http"},
+		{"GET /v2/{account}/registrar/domains/{domain}/transfer_lock", "api/getDomainTransferLock/success.http"},
+		{"POST /v2/{account}/registrar/domains/{domain}/transfer_lock", "api/enableDomainTransferLock/success.http"},
+		{"DELETE /v2/{account}/registrar/domains/{domain}/transfer_lock", "api/disableDomainTransferLock/success.http"},
+		{"PUT /v2/{account}/registrar/domains/{domain}/whois_privacy", "api/enableWhoisPrivacy/success.http"},
+		{"DELETE /v2/{account}/registrar/domains/{domain}/whois_privacy", "api/disableWhoisPrivacy/success.http"},
+		{"GET /v2/{account}/registrar/registrant_changes", "api/listRegistrantChanges/success.http"},
+		{"POST /v2/{account}/registrar/registrant_changes", "api/createRegistrantChange/success.http"},
+		{"GET /v2/{account}/registrar/registrant_changes/{change}", "api/getRegistrantChange/success.http"},
+		{"DELETE /v2/{account}/registrar/registrant_changes/{change}", "api/deleteRegistrantChange/success.http"},
+		{"POST /v2/{account}/registrar/registrant_changes/check", "api/checkRegistrantChange/success.http"},
+
+		{"GET /v2/tlds", "api/listTlds/success.http"},
+		{"GET /v2/tlds/{tld}", "api/getTld/success.http"},
+		{"GET /v2/tlds/{tld}/extended_attributes", "api/getTldExtendedAttributes/success.http"},
+
+		{"PUT /v2/{account}/vanity/{domain}", "api/enableVanityNameServers/success.http"},
+		{"DELETE /v2/{account}/vanity/{domain}", "api/disableVanityNameServers/success.http"},
+
+		{"GET /v2/{account}/webhooks", "api/listWebhooks/success.http"},
+		{"POST /v2/{account}/webhooks", "api/createWebhook/created.http"},
+		{"GET /v2/{account}/webhooks/{webhook}", "api/getWebhook/success.http"},
+		{"DELETE /v2/{account}/webhooks/{webhook}", "api/deleteWebhook/success.http"},
+
+		{"GET /v2/{account}/zones", "api/listZones/success.http"},
+		{"GET /v2/{account}/zones/{zone}", "api/getZone/success.http"},
+		{"GET /v2/{account}/zones/{zone}/file", "api/getZoneFile/success.http"},
+		{"GET /v2/{account}/zones/{zone}/distribution", "api/checkZoneDistribution/success.http"},
+		{"PUT /v2/{account}/zones/{zone}/activation", "api/activateZoneService/success.http"},
+		{"DELETE /v2/{account}/zones/{zone}/activation", "api/deactivateZoneService/success.http"},
+		{"GET /v2/{account}/zones/{zone}/records", "api/listZoneRecords/success.http"},
+		{"POST /v2/{account}/zones/{zone}/records", "api/createZoneRecord/created.http"},
+		{"GET /v2/{account}/zones/{zone}/records/{record}", "api/getZoneRecord/success.http"},
+		{"PATCH /v2/{account}/zones/{zone}/records/{record}", "api/updateZoneRecord/success.http"},
+		{"DELETE /v2/{account}/zones/{zone}/records/{record}", "api/deleteZoneRecord/success.http"},
+		{"GET /v2/{account}/zones/{zone}/records/{record}/distribution", "api/checkZoneRecordDistribution/success.http"},
+		{"POST /v2/{account}/zones/{zone}/batch", "api/batchChangeZoneRecords/success.http"},
+	}
+}
@@ -0,0 +1,209 @@
+package dnsimpletest
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// FixtureTransport is an http.RoundTripper that maps the requests to fixture files,
+// in the format of the fixtures.http directory of this repository: the raw HTTP/1.1 response,
+// with the status line, the headers and the body.
+//
+// In replay mode, created with NewFixtureTransport, it responds with the status,
+// headers and body of the fixture, without network access. NewDefaultFixtureTransport
+// replays fixtures named as in this repository, with a route for every client method:
+//
+//	transport := dnsimpletest.NewDefaultFixtureTransport(os.DirFS("fixtures.http"))
+//	transport.Handle("GET /v2/{account}/zones/{zone}", "api/notfound-zone.http")
+//	client := dnsimple.NewClient(&http.Client{Transport: transport}, dnsimple.WithToken("token"))
+//
+// In recording mode, created with NewRecordingTransport, it sends the requests
+// with another transport, typically to the sandbox, and writes the responses
+// as fixtures in the same format, so that the fixtures can be refreshed from real API payloads.
+//
+// The patterns have the syntax of http.ServeMux patterns: a method, and a path with wildcards.
+// It is safe for concurrent use.
+type FixtureTransport struct {
+	fsys      fs.FS
+	dir       string
+	transport http.RoundTripper
+
+	mu     sync.Mutex
+	routes []fixtureRoute
+}
+
+// fixtureRoute maps the requests that match a pattern to a fixture file.
+type fixtureRoute struct {
+	pattern string
+	fixture string
+
+	// mux matches the requests with the pattern only: the patterns of a transport can overlap,
+	// e.g. GET /v2/tlds/{tld} and GET /v2/{account}/zones, which a single http.ServeMux rejects.
+	mux *http.ServeMux
+}
+
+// NewFixtureTransport returns a FixtureTransport that replays the fixture files of fsys.
+func NewFixtureTransport(fsys fs.FS) *FixtureTransport {
+	return &FixtureTransport{fsys: fsys}
+}
+
+// NewDefaultFixtureTransport returns a FixtureTransport that replays the fixture files of fsys,
+// such as a copy of the fixtures.http directory of this repository, with the routes of DefaultFixtureRoutes.
+// Handle overrides the routes, e.g. to replay an error fixture.
+func NewDefaultFixtureTransport(fsys fs.FS) *FixtureTransport {
+	t := NewFixtureTransport(fsys)
+	for _, route := range DefaultFixtureRoutes() {
+		t.Handle(route.Pattern, route.Fixture)
+	}
+	return t
+}
+
+// NewRecordingTransport returns a FixtureTransport that sends the requests with transport,
+// and writes the responses as fixture files under dir.
+// If transport is nil, http.DefaultTransport is used.
+//
+// The responses of the requests that match no pattern are written to a file named
+// after the method and the path of the request, e.g. GET_v2_1010_zones.http.
+func NewRecordingTransport(dir string, transport http.RoundTripper) *FixtureTransport {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &FixtureTransport{dir: dir, transport: transport}
+}
+
+// Handle maps the requests that match the pattern to the fixture file, a slash-separated path
+// relative to the root of the fixtures.
+//
+// The patterns registered last take precedence: a pattern overrides the patterns registered before it
+// that match the same requests, and replaces the fixture of the same pattern.
+// Like http.ServeMux.Handle, it panics when the pattern is invalid.
+func (t *FixtureTransport) Handle(pattern, fixture string) {
+	mux := http.NewServeMux()
+	mux.Handle(pattern, http.NotFoundHandler())
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.routes = slices.DeleteFunc(t.routes, func(route fixtureRoute) bool {
+		return route.pattern == pattern
+	})
+	t.routes = append(t.routes, fixtureRoute{pattern: pattern, fixture: fixture, mux: mux})
+}
+
+// Fixture returns the fixture file of the request, and whether a pattern matches it.
+func (t *FixtureTransport) Fixture(req *http.Request) (string, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, route := range slices.Backward(t.routes) {
+		if _, pattern := route.mux.Handler(req); pattern != "" {
+			return route.fixture, true
+		}
+	}
+	return "", false
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (t *FixtureTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.transport != nil {
+		return t.record(req)
+	}
+
+	if req.Body != nil {
+		defer req.Body.Close()
+	}
+	fixture, ok := t.Fixture(req)
+	if !ok {
+		return nil, fmt.Errorf("dnsimpletest: no fixture for %s %s", req.Method, req.URL.Path)
+	}
+
+	data, err := fs.ReadFile(t.fsys, fixture)
+	if err != nil {
+		return nil, fmt.Errorf("dnsimpletest: reading fixture: %w", err)
+	}
+	return ReadFixture(data, req)
+}
+
+// record sends the request, and writes its response as a fixture.
+func (t *FixtureTransport) record(req *http.Request) (*http.Response, error) {
+	fixture, ok := t.Fixture(req)
+	if !ok {
+		fixture = fixtureName(req)
+	}
+
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	path := filepath.Join(t.dir, filepath.FromSlash(fixture))
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(path, WriteFixture(resp, body), 0o644); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// fixtureName returns the name of the fixture of a request that matches no pattern.
+func fixtureName(req *http.Request) string {
+	path := strings.NewReplacer("/", "_", ".", "_").Replace(strings.Trim(req.URL.Path, "/"))
+	return req.Method + "_" + path + ".http"
+}
+
+// ReadFixture parses the fixture data as the response to the request.
+func ReadFixture(data []byte, req *http.Request) (*http.Response, error) {
+	resp, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(data)), req)
+	if err != nil {
+		return nil, fmt.Errorf("dnsimpletest: parsing fixture: %w", err)
+	}
+	return resp, nil
+}
+
+// WriteFixture returns the fixture data of the response with the body.
+// The header names are lowercase and sorted, and the Content-Length and Transfer-Encoding
+// headers of the original response are omitted, as the fixture body is read to the end.
+func WriteFixture(resp *http.Response, body []byte) []byte {
+	var b bytes.Buffer
+	status := resp.Status
+	if status == "" {
+		status = fmt.Sprintf("%d %s", resp.StatusCode, http.StatusText(resp.StatusCode))
+	}
+	fmt.Fprintf(&b, "HTTP/1.1 %s\n", status)
+
+	names := make([]string, 0, len(resp.Header))
+	for name := range resp.Header {
+		switch http.CanonicalHeaderKey(name) {
+		case "Content-Length", "Transfer-Encoding":
+			continue
+		}
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		for _, value := range resp.Header[name] {
+			fmt.Fprintf(&b, "%s: %s\n", strings.ToLower(name), value)
+		}
+	}
+
+	b.WriteString("\n")
+	b.Write(body)
+	return b.Bytes()
+}
@@ -0,0 +1,117 @@
+package dnsimpletest
+
+import (
+	"context"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fixtures are the fixtures of the repository.
+var fixtures = os.DirFS("../../fixtures.http")
+
+func TestFixtureTransport(t *testing.T) {
+	transport := NewFixtureTransport(fixtures)
+	transport.Handle("GET /v2/{account}/zones/{zone}", "api/getZone/success.http")
+	transport.Handle("POST /v2/{account}/zones/{zone}/batch", "api/batchChangeZoneRecords/error_400_create_validation_failed.http")
+	client := dnsimple.NewClient(&http.Client{Transport: transport}, dnsimple.WithToken("token"))
+
+	zoneResponse, err := client.Zones.GetZone(context.Background(), "1010", "example-alpha.com")
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, zoneResponse.HTTPResponse.StatusCode)
+	assert.Equal(t, "93182033-a215-484e-a107-5235fa48001c", zoneResponse.HTTPResponse.Header.Get("X-Request-Id"))
+	assert.Equal(t, 3995, zoneResponse.RateLimitRemaining())
+	assert.Equal(t, "example-alpha.com", zoneResponse.Data.Name)
+
+	_, err = client.Zones.BatchChangeZoneRecords(context.Background(), "1010", "example.com", dnsimple.BatchChangeZoneRecordsRequest{
+		Creates: []dnsimple.ZoneRecordAttributes{{Type: "SPF", Content: "v=spf1 -all"}},
+	})
+
+	var batchError *dnsimple.BatchChangeError
+	require.ErrorAs(t, err, &batchError)
+	require.Len(t, batchError.Creates, 1)
+	assert.Equal(t, map[string][]string{"record_type": {"unsupported"}}, batchError.Creates[0].Errors)
+}
+
+func TestFixtureTransport_NoFixture(t *testing.T) {
+	transport := NewFixtureTransport(fixtures)
+	transport.Handle("GET /v2/{account}/zones/{zone}", "api/getZone/success.http")
+	transport.Handle("GET /v2/whoami", "api/whoami/missing.http")
+	client := dnsimple.NewClient(&http.Client{Transport: transport}, dnsimple.WithToken("token"))
+
+	_, err := client.Zones.ListZones(context.Background(), "1010", nil)
+	assert.ErrorContains(t, err, "dnsimpletest: no fixture for GET /v2/1010/zones")
+
+	_, err = client.Identity.Whoami(context.Background())
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestDefaultFixtureRoutes(t *testing.T) {
+	for _, route := range DefaultFixtureRoutes() {
+		_, err := fs.Stat(fixtures, route.Fixture)
+		assert.NoError(t, err, route.Pattern)
+	}
+}
+
+func TestNewDefaultFixtureTransport(t *testing.T) {
+	transport := NewDefaultFixtureTransport(fixtures)
+	client := dnsimple.NewClient(&http.Client{Transport: transport}, dnsimple.WithToken("token"))
+	ctx := context.Background()
+
+	zoneResponse, err := client.Zones.GetZone(ctx, "1010", "example-alpha.com")
+	require.NoError(t, err)
+	assert.Equal(t, "example-alpha.com", zoneResponse.Data.Name)
+
+	tldResponse, err := client.Tlds.GetTld(ctx, "com")
+	require.NoError(t, err)
+	assert.Equal(t, "com", tldResponse.Data.Tld)
+
+	// The literal path is more specific than the domain wildcard.
+	researchResponse, err := client.Domains.GetDomainResearchStatus(ctx, "1010", "taken.com")
+	require.NoError(t, err)
+	assert.Equal(t, "available", researchResponse.Data.Availability)
+
+	// A route overrides the default routes.
+	transport.Handle("GET /v2/{account}/zones/{zone}", "api/notfound-zone.http")
+	_, err = client.Zones.GetZone(ctx, "1010", "example-alpha.com")
+	assert.ErrorIs(t, err, dnsimple.ErrNotFound)
+	_, err = client.Zones.ListZones(ctx, "1010", nil)
+	assert.NoError(t, err)
+}
+
+func TestRecordingTransport(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	dir := t.TempDir()
+
+	recorder := NewRecordingTransport(dir, server.Server.Client().Transport)
+	recorder.Handle("POST /v2/{account}/domains", "api/createDomain/created.http")
+	client := dnsimple.NewClient(&http.Client{Transport: recorder}, dnsimple.WithBaseURL(server.URL), dnsimple.WithToken(server.Token))
+
+	recorded, err := client.Domains.CreateDomain(context.Background(), server.AccountID, dnsimple.Domain{Name: "example.com"})
+	require.NoError(t, err)
+	_, err = client.Domains.GetDomain(context.Background(), server.AccountID, "example.com")
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(filepath.Join(dir, "api", "createDomain", "created.http"))
+	require.NoError(t, err)
+	assert.Regexp(t, `^HTTP/1.1 201 Created\ncontent-type: application/json; charset=utf-8\ndate: .+\nx-ratelimit-limit: 2400\n`, string(data))
+	assert.FileExists(t, filepath.Join(dir, "GET_v2_"+server.AccountID+"_domains_example_com.http"))
+
+	// The recorded fixtures can be replayed.
+	transport := NewFixtureTransport(os.DirFS(dir))
+	transport.Handle("POST /v2/{account}/domains", "api/createDomain/created.http")
+	client = dnsimple.NewClient(&http.Client{Transport: transport}, dnsimple.WithToken("token"))
+
+	replayed, err := client.Domains.CreateDomain(context.Background(), server.AccountID, dnsimple.Domain{Name: "example.com"})
+	require.NoError(t, err)
+	assert.Equal(t, recorded.Data, replayed.Data)
+	assert.Equal(t, recorded.HTTPResponse.Header.Get("X-Request-Id"), replayed.HTTPResponse.Header.Get("X-Request-Id"))
+}
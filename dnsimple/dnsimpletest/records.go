@@ -0,0 +1,277 @@
+package dnsimpletest
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// recordTypes are the record types that can be created.
+var recordTypes = []string{
+	"A", "AAAA", "ALIAS", "CAA", "CNAME", "DNSKEY", "DS", "HINFO", "MX", "NAPTR",
+	"NS", "POOL", "PTR", "SPF", "SRV", "SSHFP", "TLSA", "TXT", "URL",
+}
+
+// prioritizedTypes are the record types that have a priority.
+var prioritizedTypes = []string{"MX", "SRV"}
+
+// defaultTTL is the TTL of the records created without one.
+const defaultTTL = 3600
+
+// recordAttributes are the attributes of a record create or update request.
+type recordAttributes struct {
+	ID       int64    `json:"id"`
+	Type     string   `json:"type"`
+	Name     *string  `json:"name"`
+	Content  string   `json:"content"`
+	TTL      int      `json:"ttl"`
+	Priority *int     `json:"priority"`
+	Regions  []string `json:"regions"`
+}
+
+// newRecord returns the record created with the attributes,
+// or the validation errors of the attributes.
+func (s *Server) newRecord(z *zone, attributes recordAttributes) (*recordJSON, validationErrors) {
+	now := s.timestamp()
+	record := &recordJSON{
+		ZoneID:    z.zone.Name,
+		Type:      strings.ToUpper(attributes.Type),
+		Content:   attributes.Content,
+		TTL:       attributes.TTL,
+		Regions:   attributes.Regions,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if attributes.Name != nil {
+		record.Name = *attributes.Name
+	}
+	if record.TTL == 0 {
+		record.TTL = defaultTTL
+	}
+	if len(record.Regions) == 0 {
+		record.Regions = []string{"global"}
+	}
+	if slices.Contains(prioritizedTypes, record.Type) {
+		record.Priority = new(int)
+		if attributes.Priority != nil {
+			*record.Priority = *attributes.Priority
+		}
+	}
+
+	if errors := validateRecord(record); len(errors) > 0 {
+		return nil, errors
+	}
+	record.ID = s.id()
+	return record, nil
+}
+
+// updatedRecord returns a copy of the record updated with the attributes,
+// or the validation errors of the attributes.
+func (s *Server) updatedRecord(record *recordJSON, attributes recordAttributes) (*recordJSON, validationErrors) {
+	if record.SystemRecord {
+		return nil, validationErrors{"base": {"System records can't be changed"}}
+	}
+
+	updated := *record
+	if attributes.Type != "" {
+		updated.Type = strings.ToUpper(attributes.Type)
+	}
+	if attributes.Name != nil {
+		updated.Name = *attributes.Name
+	}
+	if attributes.Content != "" {
+		updated.Content = attributes.Content
+	}
+	if attributes.TTL != 0 {
+		updated.TTL = attributes.TTL
+	}
+	if len(attributes.Regions) > 0 {
+		updated.Regions = attributes.Regions
+	}
+	switch {
+	case !slices.Contains(prioritizedTypes, updated.Type):
+		updated.Priority = nil
+	case attributes.Priority != nil:
+		updated.Priority = attributes.Priority
+	case updated.Priority == nil:
+		updated.Priority = new(int)
+	}
+
+	if errors := validateRecord(&updated); len(errors) > 0 {
+		return nil, errors
+	}
+	updated.UpdatedAt = s.timestamp()
+	return &updated, nil
+}
+
+// validateRecord returns the validation errors of the record.
+func validateRecord(record *recordJSON) validationErrors {
+	errors := validationErrors{}
+	if !slices.Contains(recordTypes, record.Type) {
+		errors.add("record_type", "unsupported")
+	}
+	if record.Content == "" {
+		errors.add("content", "can't be blank")
+	}
+	if record.TTL < 0 {
+		errors.add("ttl", "must be greater than or equal to 0")
+	}
+	if strings.HasSuffix(record.Name, ".") {
+		errors.add("name", "is invalid")
+	}
+	return errors
+}
+
+// findRecord returns the record of the zone with the ID.
+func (z *zone) findRecord(id int64) (*recordJSON, int) {
+	return find(z.records, func(record *recordJSON) bool { return record.ID == id })
+}
+
+// withRecord calls h with the record of the {record} wildcard,
+// or writes a 404 response when it doesn't exist.
+func withRecord(w http.ResponseWriter, r *http.Request, a *account, h func(z *zone, record *recordJSON, index int)) {
+	withZone(w, r, a, func(z *zone) {
+		record, index := z.findRecord(pathID(r, "record"))
+		if record == nil {
+			writeNotFound(w, "Record", r.PathValue("record"))
+			return
+		}
+		h(z, record, index)
+	})
+}
+
+func (s *Server) listRecords(w http.ResponseWriter, r *http.Request, a *account) {
+	withZone(w, r, a, func(z *zone) {
+		query := r.URL.Query()
+
+		records := []*recordJSON{}
+		for _, record := range z.records {
+			switch {
+			case query.Has("name") && record.Name != query.Get("name"):
+			case !strings.Contains(record.Name, query.Get("name_like")):
+			case query.Has("type") && !strings.EqualFold(record.Type, query.Get("type")):
+			default:
+				records = append(records, record)
+			}
+		}
+		writePage(w, r, records)
+	})
+}
+
+func (s *Server) createRecord(w http.ResponseWriter, r *http.Request, a *account) {
+	withZone(w, r, a, func(z *zone) {
+		var attributes recordAttributes
+		if !decode(w, r, &attributes) {
+			return
+		}
+
+		record, errors := s.newRecord(z, attributes)
+		if errors != nil {
+			writeValidationErrors(w, errors)
+			return
+		}
+		z.records = append(z.records, record)
+
+		writeData(w, http.StatusCreated, record)
+	})
+}
+
+func (s *Server) getRecord(w http.ResponseWriter, r *http.Request, a *account) {
+	withRecord(w, r, a, func(_ *zone, record *recordJSON, _ int) {
+		writeData(w, http.StatusOK, record)
+	})
+}
+
+func (s *Server) updateRecord(w http.ResponseWriter, r *http.Request, a *account) {
+	withRecord(w, r, a, func(z *zone, record *recordJSON, index int) {
+		var attributes recordAttributes
+		if !decode(w, r, &attributes) {
+			return
+		}
+
+		updated, errors := s.updatedRecord(record, attributes)
+		if errors != nil {
+			writeValidationErrors(w, errors)
+			return
+		}
+		z.records[index] = updated
+
+		writeData(w, http.StatusOK, updated)
+	})
+}
+
+func (s *Server) deleteRecord(w http.ResponseWriter, r *http.Request, a *account) {
+	withRecord(w, r, a, func(z *zone, record *recordJSON, index int) {
+		if record.SystemRecord {
+			writeValidationErrors(w, validationErrors{"base": {"System records can't be deleted"}})
+			return
+		}
+		z.records = slices.Delete(z.records, index, index+1)
+		writeNoContent(w)
+	})
+}
+
+// batchChangeRecords applies the creates, updates and deletes of the request atomically:
+// when one of the operations is invalid, none is applied.
+func (s *Server) batchChangeRecords(w http.ResponseWriter, r *http.Request, a *account) {
+	withZone(w, r, a, func(z *zone) {
+		var request struct {
+			Creates []recordAttributes `json:"creates"`
+			Updates []recordAttributes `json:"updates"`
+			Deletes []recordAttributes `json:"deletes"`
+		}
+		if !decode(w, r, &request) {
+			return
+		}
+
+		// The operations are validated against a copy of the records,
+		// which replaces the records of the zone only when all of them are valid.
+		records := slices.Clone(z.records)
+		var result batchChangeJSON
+		var failures batchErrorsJSON
+
+		for i, attributes := range request.Deletes {
+			_, index := find(records, func(record *recordJSON) bool { return record.ID == attributes.ID })
+			if index < 0 || records[index].SystemRecord {
+				failures.Deletes = append(failures.Deletes, batchErrorJSON{Index: i, Message: fmt.Sprintf("Record not found ID=%d", attributes.ID)})
+				continue
+			}
+			records = slices.Delete(records, index, index+1)
+			result.Deletes = append(result.Deletes, recordIDJSON{ID: attributes.ID})
+		}
+
+		for i, attributes := range request.Updates {
+			record, index := find(records, func(record *recordJSON) bool { return record.ID == attributes.ID })
+			if record == nil {
+				failures.Updates = append(failures.Updates, batchErrorJSON{Index: i, Message: fmt.Sprintf("Record not found ID=%d", attributes.ID)})
+				continue
+			}
+			updated, errors := s.updatedRecord(record, attributes)
+			if errors != nil {
+				failures.Updates = append(failures.Updates, batchErrorJSON{Index: i, Message: "Validation failed", Errors: errors})
+				continue
+			}
+			records[index] = updated
+			result.Updates = append(result.Updates, updated)
+		}
+
+		for i, attributes := range request.Creates {
+			record, errors := s.newRecord(z, attributes)
+			if errors != nil {
+				failures.Creates = append(failures.Creates, batchErrorJSON{Index: i, Message: "Validation failed", Errors: errors})
+				continue
+			}
+			records = append(records, record)
+			result.Creates = append(result.Creates, record)
+		}
+
+		if failures.Creates != nil || failures.Updates != nil || failures.Deletes != nil {
+			writeValidationErrors(w, failures)
+			return
+		}
+		z.records = records
+
+		writeData(w, http.StatusOK, result)
+	})
+}
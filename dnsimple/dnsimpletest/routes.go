@@ -0,0 +1,70 @@
+package dnsimpletest
+
+import (
+	"net/http"
+)
+
+// routes registers the handlers of the API endpoints supported by the fake.
+func (s *Server) routes() {
+	s.mux = http.NewServeMux()
+	s.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "Not found")
+	})
+
+	s.handle("GET /v2/whoami", s.whoami)
+	s.handle("GET /v2/accounts", s.listAccounts)
+
+	s.handle("GET /v2/{account}/domains", s.listDomains)
+	s.handle("POST /v2/{account}/domains", s.createDomain)
+	s.handle("GET /v2/{account}/domains/{domain}", s.getDomain)
+	s.handle("DELETE /v2/{account}/domains/{domain}", s.deleteDomain)
+
+	s.handle("GET /v2/{account}/domains/{domain}/email_forwards", s.listEmailForwards)
+	s.handle("POST /v2/{account}/domains/{domain}/email_forwards", s.createEmailForward)
+	s.handle("GET /v2/{account}/domains/{domain}/email_forwards/{forward}", s.getEmailForward)
+	s.handle("DELETE /v2/{account}/domains/{domain}/email_forwards/{forward}", s.deleteEmailForward)
+
+	s.handle("POST /v2/{account}/domains/{domain}/templates/{template}", s.applyTemplate)
+
+	s.handle("GET /v2/{account}/zones", s.listZones)
+	s.handle("GET /v2/{account}/zones/{zone}", s.getZone)
+	s.handle("GET /v2/{account}/zones/{zone}/file", s.getZoneFile)
+	s.handle("PUT /v2/{account}/zones/{zone}/activation", s.activateZone)
+	s.handle("DELETE /v2/{account}/zones/{zone}/activation", s.deactivateZone)
+
+	s.handle("GET /v2/{account}/zones/{zone}/records", s.listRecords)
+	s.handle("POST /v2/{account}/zones/{zone}/records", s.createRecord)
+	s.handle("GET /v2/{account}/zones/{zone}/records/{record}", s.getRecord)
+	s.handle("PATCH /v2/{account}/zones/{zone}/records/{record}", s.updateRecord)
+	s.handle("DELETE /v2/{account}/zones/{zone}/records/{record}", s.deleteRecord)
+	s.handle("POST /v2/{account}/zones/{zone}/batch", s.batchChangeRecords)
+
+	s.handle("GET /v2/{account}/contacts", s.listContacts)
+	s.handle("POST /v2/{account}/contacts", s.createContact)
+	s.handle("GET /v2/{account}/contacts/{contact}", s.getContact)
+	s.handle("PATCH /v2/{account}/contacts/{contact}", s.updateContact)
+	s.handle("DELETE /v2/{account}/contacts/{contact}", s.deleteContact)
+
+	s.handle("GET /v2/{account}/templates", s.listTemplates)
+	s.handle("POST /v2/{account}/templates", s.createTemplate)
+	s.handle("GET /v2/{account}/templates/{template}", s.getTemplate)
+	s.handle("PATCH /v2/{account}/templates/{template}", s.updateTemplate)
+	s.handle("DELETE /v2/{account}/templates/{template}", s.deleteTemplate)
+	s.handle("GET /v2/{account}/templates/{template}/records", s.listTemplateRecords)
+	s.handle("POST /v2/{account}/templates/{template}/records", s.createTemplateRecord)
+	s.handle("GET /v2/{account}/templates/{template}/records/{record}", s.getTemplateRecord)
+	s.handle("DELETE /v2/{account}/templates/{template}/records/{record}", s.deleteTemplateRecord)
+
+	s.handle("GET /v2/{account}/webhooks", s.listWebhooks)
+	s.handle("POST /v2/{account}/webhooks", s.createWebhook)
+	s.handle("GET /v2/{account}/webhooks/{webhook}", s.getWebhook)
+	s.handle("DELETE /v2/{account}/webhooks/{webhook}", s.deleteWebhook)
+}
+
+func (s *Server) whoami(w http.ResponseWriter, r *http.Request, a *account) {
+	writeData(w, http.StatusOK, whoamiJSON{Account: &a.account})
+}
+
+func (s *Server) listAccounts(w http.ResponseWriter, r *http.Request, a *account) {
+	writeData(w, http.StatusOK, []accountJSON{a.account})
+}
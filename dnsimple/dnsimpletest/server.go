@@ -0,0 +1,287 @@
+// Package dnsimpletest provides an in-memory fake of the DNSimple API, for integration tests
+// that use a real *dnsimple.Client without network access.
+//
+// The fake keeps the state of accounts, domains, email forwards, zones, zone records,
+// contacts, templates and webhooks, and responds with the same JSON shapes and error
+// formats as the real API:
+//
+//	server := dnsimpletest.NewServer()
+//	defer server.Close()
+//
+//	client := server.Client()
+//	_, err := client.Domains.CreateDomain(ctx, server.AccountID, dnsimple.Domain{Name: "example.com"})
+//	// the zone of the domain is created with its SOA and NS system records
+//	records, err := client.Zones.ListRecords(ctx, server.AccountID, "example.com", nil)
+package dnsimpletest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+)
+
+const (
+	// DefaultPerPage is the number of entries per page when the request doesn't set it.
+	DefaultPerPage = 30
+
+	// MaxPerPage is the maximum number of entries per page.
+	MaxPerPage = 100
+
+	// rateLimit is the rate limit reported in the X-RateLimit-* headers.
+	rateLimit = 2400
+)
+
+// Server is an in-memory fake of the DNSimple API.
+// It is safe for concurrent use.
+type Server struct {
+	*httptest.Server
+
+	// AccountID is the ID of the account created with the server.
+	AccountID string
+
+	// Token is the API token of the account created with the server.
+	Token string
+
+	mu       sync.Mutex
+	mux      *http.ServeMux
+	nextID   int64
+	requests int
+	accounts map[int64]*account
+	tokens   map[string]int64
+	now      func() time.Time
+}
+
+// account is the state of an account.
+type account struct {
+	account   accountJSON
+	domains   []*domain
+	zones     []*zone
+	contacts  []*contactJSON
+	templates []*template
+	webhooks  []*webhookJSON
+}
+
+// NewServer starts and returns a new Server, with an account and its API token.
+// The caller should call Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		accounts: map[int64]*account{},
+		tokens:   map[string]int64{},
+		nextID:   1,
+		now:      time.Now,
+	}
+	s.routes()
+	s.Server = httptest.NewServer(s)
+
+	id, token := s.AddAccount("example-account@example.com")
+	s.AccountID = strconv.FormatInt(id, 10)
+	s.Token = token
+	return s
+}
+
+// Client returns a client for the server, authenticated with Token.
+// The options are applied after the ones that configure the endpoint and the token.
+func (s *Server) Client(opts ...dnsimple.Option) *dnsimple.Client {
+	opts = append([]dnsimple.Option{dnsimple.WithBaseURL(s.URL), dnsimple.WithToken(s.Token)}, opts...)
+	return dnsimple.NewClient(s.Server.Client(), opts...)
+}
+
+// AddAccount creates an account, and returns its ID and an API token scoped to it.
+func (s *Server) AddAccount(email string) (int64, string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.timestamp()
+	id := s.id()
+	s.accounts[id] = &account{account: accountJSON{
+		ID:             id,
+		Email:          email,
+		Name:           email,
+		PlanIdentifier: "teams-v2-monthly",
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}}
+
+	token := fmt.Sprintf("dnsimpletest_a_%d", id)
+	s.tokens[token] = id
+	return id, token
+}
+
+// SetClock sets the function that returns the current time,
+// used for the created_at and updated_at timestamps.
+func (s *Server) SetClock(now func() time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.now = now
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests++
+	w.Header().Set("X-Request-Id", fmt.Sprintf("dnsimpletest-%d", s.requests))
+	w.Header().Set("X-RateLimit-Limit", strconv.Itoa(rateLimit))
+	w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(rateLimit-s.requests%rateLimit))
+	w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(s.now().Add(time.Hour).Unix(), 10))
+
+	s.mux.ServeHTTP(w, r)
+}
+
+// handlerFunc handles a request authenticated with a token of the account.
+type handlerFunc func(w http.ResponseWriter, r *http.Request, a *account)
+
+// handle registers the handler for the pattern.
+// The request must be authenticated, and the {account} wildcard, if any,
+// must match the account of the token.
+func (s *Server) handle(pattern string, h handlerFunc) {
+	s.mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		token, ok := bearerToken(r)
+		accountID, found := s.tokens[token]
+		if !ok || !found {
+			writeError(w, http.StatusUnauthorized, "Authentication failed")
+			return
+		}
+
+		a := s.accounts[accountID]
+		if value := r.PathValue("account"); value != "" && value != strconv.FormatInt(accountID, 10) {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("Account `%s` not found", value))
+			return
+		}
+		h(w, r, a)
+	})
+}
+
+// bearerToken returns the token of the Authorization header.
+func bearerToken(r *http.Request) (string, bool) {
+	const prefix = "Bearer "
+	header := r.Header.Get("Authorization")
+	if len(header) <= len(prefix) || header[:len(prefix)] != prefix {
+		return "", false
+	}
+	return header[len(prefix):], true
+}
+
+// id returns a new unique ID.
+func (s *Server) id() int64 {
+	id := s.nextID
+	s.nextID++
+	return id
+}
+
+// timestamp returns the current time, formatted as in the API responses.
+func (s *Server) timestamp() string {
+	return s.now().UTC().Format(time.RFC3339)
+}
+
+// dataResponse is the envelope of the API responses.
+type dataResponse struct {
+	Data       interface{}          `json:"data"`
+	Pagination *dnsimple.Pagination `json:"pagination,omitempty"`
+}
+
+// errorResponse is the body of the API error responses.
+type errorResponse struct {
+	Message string      `json:"message"`
+	Errors  interface{} `json:"errors,omitempty"`
+}
+
+// validationErrors are the errors of the attributes of a request, by attribute.
+type validationErrors map[string][]string
+
+// add adds an error for the attribute.
+func (e validationErrors) add(attribute, message string) {
+	e[attribute] = append(e[attribute], message)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeData(w http.ResponseWriter, status int, data interface{}) {
+	writeJSON(w, status, dataResponse{Data: data})
+}
+
+func writeNoContent(w http.ResponseWriter) {
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, errorResponse{Message: message})
+}
+
+func writeValidationErrors(w http.ResponseWriter, errors interface{}) {
+	writeJSON(w, http.StatusBadRequest, errorResponse{Message: "Validation failed", Errors: errors})
+}
+
+func writeNotFound(w http.ResponseWriter, resource, identifier string) {
+	writeError(w, http.StatusNotFound, fmt.Sprintf("%s `%s` not found", resource, identifier))
+}
+
+// writePage writes the page of the entries selected by the page and per_page query parameters.
+func writePage[T any](w http.ResponseWriter, r *http.Request, entries []T) {
+	query := r.URL.Query()
+	page, err := strconv.Atoi(query.Get("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	perPage, err := strconv.Atoi(query.Get("per_page"))
+	if err != nil || perPage < 1 {
+		perPage = DefaultPerPage
+	}
+	perPage = min(perPage, MaxPerPage)
+
+	start := min((page-1)*perPage, len(entries))
+	end := min(start+perPage, len(entries))
+	data := slices.Clone(entries[start:end])
+	if data == nil {
+		data = []T{}
+	}
+
+	writeJSON(w, http.StatusOK, dataResponse{
+		Data: data,
+		Pagination: &dnsimple.Pagination{
+			CurrentPage:  page,
+			PerPage:      perPage,
+			TotalEntries: len(entries),
+			TotalPages:   max(1, (len(entries)+perPage-1)/perPage),
+		},
+	})
+}
+
+// decode decodes the JSON body of the request into v.
+// It writes a 400 response and returns false when the body is invalid.
+func decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid JSON body: "+err.Error())
+		return false
+	}
+	return true
+}
+
+// pathID parses the wildcard of the request as an ID.
+// It returns 0 when the wildcard is not a number.
+func pathID(r *http.Request, name string) int64 {
+	id, _ := strconv.ParseInt(r.PathValue(name), 10, 64)
+	return id
+}
+
+// find returns the first entry that matches.
+func find[T any](entries []*T, match func(*T) bool) (*T, int) {
+	for i, entry := range entries {
+		if match(entry) {
+			return entry, i
+		}
+	}
+	return nil, -1
+}
@@ -0,0 +1,366 @@
+package dnsimpletest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestServer(t *testing.T) (*Server, *dnsimple.Client) {
+	t.Helper()
+	server := NewServer()
+	t.Cleanup(server.Close)
+	server.SetClock(func() time.Time { return time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC) })
+	return server, server.Client()
+}
+
+func TestServer_Whoami(t *testing.T) {
+	server, client := newTestServer(t)
+
+	whoamiResponse, err := client.Identity.Whoami(context.Background())
+
+	require.NoError(t, err)
+	assert.Nil(t, whoamiResponse.Data.User)
+	assert.Equal(t, server.AccountID, strconv.FormatInt(whoamiResponse.Data.Account.ID, 10))
+	assert.Equal(t, "example-account@example.com", whoamiResponse.Data.Account.Email)
+	assert.Equal(t, 2400, whoamiResponse.RateLimit())
+}
+
+func TestServer_Unauthorized(t *testing.T) {
+	server, _ := newTestServer(t)
+	client := dnsimple.NewClient(server.Server.Client(), dnsimple.WithBaseURL(server.URL), dnsimple.WithToken("invalid"))
+
+	_, err := client.Identity.Whoami(context.Background())
+
+	assert.ErrorIs(t, err, dnsimple.ErrUnauthorized)
+}
+
+func TestServer_OtherAccount(t *testing.T) {
+	server, client := newTestServer(t)
+	otherID, otherToken := server.AddAccount("other@example.com")
+	otherClient := dnsimple.NewClient(server.Server.Client(), dnsimple.WithBaseURL(server.URL), dnsimple.WithToken(otherToken))
+
+	_, err := otherClient.Domains.ListDomains(context.Background(), strconv.FormatInt(otherID, 10), nil)
+	require.NoError(t, err)
+
+	_, err = client.Domains.ListDomains(context.Background(), strconv.FormatInt(otherID, 10), nil)
+
+	var errorResponse *dnsimple.ErrorResponse
+	require.ErrorAs(t, err, &errorResponse)
+	assert.Equal(t, http.StatusNotFound, errorResponse.HTTPResponse.StatusCode)
+	assert.Equal(t, "Account `"+strconv.FormatInt(otherID, 10)+"` not found", errorResponse.Message)
+}
+
+func TestServer_Domains(t *testing.T) {
+	server, client := newTestServer(t)
+	ctx := context.Background()
+
+	domainResponse, err := client.Domains.CreateDomain(ctx, server.AccountID, dnsimple.Domain{Name: "example.com"})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, domainResponse.HTTPResponse.StatusCode)
+	assert.Equal(t, "example.com", domainResponse.Data.Name)
+	assert.Equal(t, "hosted", domainResponse.Data.State)
+	assert.Equal(t, "2026-01-02T03:04:05Z", domainResponse.Data.CreatedAt)
+
+	_, err = client.Domains.CreateDomain(ctx, server.AccountID, dnsimple.Domain{Name: "example.org"})
+	require.NoError(t, err)
+
+	domainsResponse, err := client.Domains.ListDomains(ctx, server.AccountID, &dnsimple.DomainListOptions{NameLike: dnsimple.String(".org")})
+	require.NoError(t, err)
+	require.Len(t, domainsResponse.Data, 1)
+	assert.Equal(t, "example.org", domainsResponse.Data[0].Name)
+	assert.Equal(t, 1, domainsResponse.Pagination.TotalEntries)
+
+	domainResponse, err = client.Domains.GetDomain(ctx, server.AccountID, strconv.FormatInt(domainResponse.Data.ID, 10))
+	require.NoError(t, err)
+	assert.Equal(t, "example.com", domainResponse.Data.Name)
+
+	_, err = client.Domains.CreateDomain(ctx, server.AccountID, dnsimple.Domain{Name: "example.com"})
+	var errorResponse *dnsimple.ErrorResponse
+	require.ErrorAs(t, err, &errorResponse)
+	assert.Equal(t, "Validation failed", errorResponse.Message)
+	assert.Equal(t, map[string][]string{"name": {"has already been taken"}}, errorResponse.AttributeErrors)
+
+	_, err = client.Domains.DeleteDomain(ctx, server.AccountID, "example.com")
+	require.NoError(t, err)
+
+	_, err = client.Domains.GetDomain(ctx, server.AccountID, "example.com")
+	assert.ErrorIs(t, err, dnsimple.ErrNotFound)
+	require.ErrorAs(t, err, &errorResponse)
+	assert.Equal(t, "Domain `example.com` not found", errorResponse.Message)
+
+	_, err = client.Zones.GetZone(ctx, server.AccountID, "example.com")
+	assert.ErrorIs(t, err, dnsimple.ErrNotFound)
+}
+
+func TestServer_Pagination(t *testing.T) {
+	server, client := newTestServer(t)
+	ctx := context.Background()
+
+	for i := range 5 {
+		_, err := client.Domains.CreateDomain(ctx, server.AccountID, dnsimple.Domain{Name: "example" + strconv.Itoa(i) + ".com"})
+		require.NoError(t, err)
+	}
+
+	var names []string
+	for domain, err := range client.Domains.ListDomainsIter(ctx, server.AccountID, &dnsimple.DomainListOptions{ListOptions: dnsimple.ListOptions{PerPage: dnsimple.Int(2)}}) {
+		require.NoError(t, err)
+		names = append(names, domain.Name)
+	}
+	assert.Equal(t, []string{"example0.com", "example1.com", "example2.com", "example3.com", "example4.com"}, names)
+}
+
+func TestServer_EmailForwards(t *testing.T) {
+	server, client := newTestServer(t)
+	ctx := context.Background()
+
+	_, err := client.Domains.CreateDomain(ctx, server.AccountID, dnsimple.Domain{Name: "example.com"})
+	require.NoError(t, err)
+
+	forwardResponse, err := client.Domains.CreateEmailForward(ctx, server.AccountID, "example.com", dnsimple.EmailForward{AliasName: "info", DestinationEmail: "john@example.org"})
+	require.NoError(t, err)
+	assert.Equal(t, "info@example.com", forwardResponse.Data.AliasEmail)
+	assert.True(t, forwardResponse.Data.Active)
+
+	forwardsResponse, err := client.Domains.ListEmailForwards(ctx, server.AccountID, "example.com", nil)
+	require.NoError(t, err)
+	assert.Len(t, forwardsResponse.Data, 1)
+
+	_, err = client.Domains.DeleteEmailForward(ctx, server.AccountID, "example.com", forwardResponse.Data.ID)
+	require.NoError(t, err)
+
+	_, err = client.Domains.GetEmailForward(ctx, server.AccountID, "example.com", forwardResponse.Data.ID)
+	assert.ErrorIs(t, err, dnsimple.ErrNotFound)
+}
+
+func TestServer_Zones(t *testing.T) {
+	server, client := newTestServer(t)
+	ctx := context.Background()
+
+	_, err := client.Domains.CreateDomain(ctx, server.AccountID, dnsimple.Domain{Name: "example.com"})
+	require.NoError(t, err)
+
+	zoneResponse, err := client.Zones.GetZone(ctx, server.AccountID, "example.com")
+	require.NoError(t, err)
+	assert.True(t, zoneResponse.Data.Active)
+
+	zoneResponse, err = client.Zones.DeactivateZoneDns(ctx, server.AccountID, "example.com")
+	require.NoError(t, err)
+	assert.False(t, zoneResponse.Data.Active)
+
+	recordsResponse, err := client.Zones.ListRecords(ctx, server.AccountID, "example.com", nil)
+	require.NoError(t, err)
+	require.Len(t, recordsResponse.Data, 5)
+	assert.Equal(t, "SOA", recordsResponse.Data[0].Type)
+	for _, record := range recordsResponse.Data {
+		assert.True(t, record.SystemRecord)
+	}
+
+	_, err = client.Zones.CreateRecord(ctx, server.AccountID, "example.com", dnsimple.ZoneRecordAttributes{Name: dnsimple.String(""), Type: "MX", Content: "mx.example.com", Priority: 10})
+	require.NoError(t, err)
+
+	fileResponse, err := client.Zones.GetZoneFile(ctx, server.AccountID, "example.com")
+	require.NoError(t, err)
+	assert.Contains(t, fileResponse.Data.Zone, "$ORIGIN example.com.\n$TTL 1h\n")
+	assert.Contains(t, fileResponse.Data.Zone, "example.com. 3600 IN NS ns1.dnsimple.com.\n")
+	assert.Contains(t, fileResponse.Data.Zone, "example.com. 3600 IN MX 10 mx.example.com.\n")
+}
+
+func TestServer_Records(t *testing.T) {
+	server, client := newTestServer(t)
+	ctx := context.Background()
+
+	_, err := client.Domains.CreateDomain(ctx, server.AccountID, dnsimple.Domain{Name: "example.com"})
+	require.NoError(t, err)
+
+	recordResponse, err := client.Zones.CreateRecord(ctx, server.AccountID, "example.com", dnsimple.ZoneRecordAttributes{Name: dnsimple.String("www"), Type: "A", Content: "127.0.0.1"})
+	require.NoError(t, err)
+	record := recordResponse.Data
+	assert.Equal(t, "example.com", record.ZoneID)
+	assert.Equal(t, 3600, record.TTL)
+	assert.Equal(t, 0, record.Priority)
+	assert.Equal(t, []string{"global"}, record.Regions)
+
+	recordResponse, err = client.Zones.UpdateRecord(ctx, server.AccountID, "example.com", record.ID, dnsimple.ZoneRecordAttributes{Content: "127.0.0.2", TTL: 600})
+	require.NoError(t, err)
+	assert.Equal(t, "www", recordResponse.Data.Name)
+	assert.Equal(t, "127.0.0.2", recordResponse.Data.Content)
+	assert.Equal(t, 600, recordResponse.Data.TTL)
+
+	recordsResponse, err := client.Zones.ListRecords(ctx, server.AccountID, "example.com", &dnsimple.ZoneRecordListOptions{Name: dnsimple.String("www"), Type: dnsimple.String("A")})
+	require.NoError(t, err)
+	require.Len(t, recordsResponse.Data, 1)
+	assert.Equal(t, record.ID, recordsResponse.Data[0].ID)
+
+	recordsResponse, err = client.Zones.ListRecords(ctx, server.AccountID, "example.com", &dnsimple.ZoneRecordListOptions{Name: dnsimple.String("")})
+	require.NoError(t, err)
+	assert.Len(t, recordsResponse.Data, 5)
+
+	_, err = client.Zones.CreateRecord(ctx, server.AccountID, "example.com", dnsimple.ZoneRecordAttributes{Name: dnsimple.String("www"), Type: "SOA"})
+	var errorResponse *dnsimple.ErrorResponse
+	require.ErrorAs(t, err, &errorResponse)
+	assert.Equal(t, http.StatusBadRequest, errorResponse.HTTPResponse.StatusCode)
+	assert.Equal(t, map[string][]string{"record_type": {"unsupported"}, "content": {"can't be blank"}}, errorResponse.AttributeErrors)
+
+	_, err = client.Zones.DeleteRecord(ctx, server.AccountID, "example.com", recordsResponse.Data[0].ID)
+	require.ErrorAs(t, err, &errorResponse)
+	assert.Equal(t, http.StatusBadRequest, errorResponse.HTTPResponse.StatusCode)
+
+	_, err = client.Zones.DeleteRecord(ctx, server.AccountID, "example.com", record.ID)
+	require.NoError(t, err)
+
+	_, err = client.Zones.GetRecord(ctx, server.AccountID, "example.com", record.ID)
+	require.ErrorAs(t, err, &errorResponse)
+	assert.Equal(t, "Record `"+strconv.FormatInt(record.ID, 10)+"` not found", errorResponse.Message)
+}
+
+func TestServer_BatchChangeZoneRecords(t *testing.T) {
+	server, client := newTestServer(t)
+	ctx := context.Background()
+
+	_, err := client.Domains.CreateDomain(ctx, server.AccountID, dnsimple.Domain{Name: "example.com"})
+	require.NoError(t, err)
+	updated, err := client.Zones.CreateRecord(ctx, server.AccountID, "example.com", dnsimple.ZoneRecordAttributes{Name: dnsimple.String("update"), Type: "A", Content: "1.2.3.4"})
+	require.NoError(t, err)
+	deleted, err := client.Zones.CreateRecord(ctx, server.AccountID, "example.com", dnsimple.ZoneRecordAttributes{Name: dnsimple.String("delete"), Type: "A", Content: "1.2.3.4"})
+	require.NoError(t, err)
+
+	batchResponse, err := client.Zones.BatchChangeZoneRecords(ctx, server.AccountID, "example.com", dnsimple.BatchChangeZoneRecordsRequest{
+		Creates: []dnsimple.ZoneRecordAttributes{{Name: dnsimple.String("create"), Type: "TXT", Content: "hello"}},
+		Updates: []dnsimple.ZoneRecordUpdateRequest{{ID: updated.Data.ID, Content: "4.3.2.1"}},
+		Deletes: []dnsimple.ZoneRecordDeleteRequest{{ID: deleted.Data.ID}},
+	})
+	require.NoError(t, err)
+	require.Len(t, batchResponse.Data.Creates, 1)
+	assert.Equal(t, "create", batchResponse.Data.Creates[0].Name)
+	require.Len(t, batchResponse.Data.Updates, 1)
+	assert.Equal(t, "4.3.2.1", batchResponse.Data.Updates[0].Content)
+	assert.Equal(t, []dnsimple.ZoneRecordDeleteResult{{ID: deleted.Data.ID}}, batchResponse.Data.Deletes)
+
+	// A failed operation rolls back the whole batch.
+	_, err = client.Zones.BatchChangeZoneRecords(ctx, server.AccountID, "example.com", dnsimple.BatchChangeZoneRecordsRequest{
+		Creates: []dnsimple.ZoneRecordAttributes{{Name: dnsimple.String("valid"), Type: "A", Content: "1.2.3.4"}, {Name: dnsimple.String("invalid"), Type: "XYZ", Content: "1.2.3.4"}},
+		Deletes: []dnsimple.ZoneRecordDeleteRequest{{ID: updated.Data.ID}, {ID: deleted.Data.ID}},
+	})
+	var batchError *dnsimple.BatchChangeError
+	require.True(t, errors.As(err, &batchError))
+	require.Len(t, batchError.Creates, 1)
+	assert.Equal(t, 1, batchError.Creates[0].Index)
+	assert.Equal(t, map[string][]string{"record_type": {"unsupported"}}, batchError.Creates[0].Errors)
+	require.Len(t, batchError.Deletes, 1)
+	assert.Equal(t, 1, batchError.Deletes[0].Index)
+	assert.Equal(t, "Record not found ID="+strconv.FormatInt(deleted.Data.ID, 10), batchError.Deletes[0].Message)
+
+	recordsResponse, err := client.Zones.ListRecords(ctx, server.AccountID, "example.com", &dnsimple.ZoneRecordListOptions{NameLike: dnsimple.String("a")})
+	require.NoError(t, err)
+	var names []string
+	for _, record := range recordsResponse.Data {
+		names = append(names, record.Name)
+	}
+	assert.Equal(t, []string{"update", "create"}, names)
+}
+
+func TestServer_Contacts(t *testing.T) {
+	server, client := newTestServer(t)
+	ctx := context.Background()
+
+	_, err := client.Contacts.CreateContact(ctx, server.AccountID, dnsimple.Contact{Label: "Empty"})
+	var errorResponse *dnsimple.ErrorResponse
+	require.ErrorAs(t, err, &errorResponse)
+	assert.Equal(t, []string{"can't be blank"}, errorResponse.AttributeErrors["first_name"])
+	assert.Len(t, errorResponse.AttributeErrors, 9)
+
+	contactResponse, err := client.Contacts.CreateContact(ctx, server.AccountID, dnsimple.Contact{
+		FirstName:     "John",
+		LastName:      "Smith",
+		Address1:      "Italian Street",
+		City:          "Roma",
+		StateProvince: "RM",
+		PostalCode:    "00100",
+		Country:       "IT",
+		Email:         "john.smith@example.com",
+		Phone:         "+39 06 12345678",
+	})
+	require.NoError(t, err)
+	contactID := contactResponse.Data.ID
+
+	contactResponse, err = client.Contacts.UpdateContact(ctx, server.AccountID, contactID, dnsimple.Contact{City: "Milano"})
+	require.NoError(t, err)
+	assert.Equal(t, "Milano", contactResponse.Data.City)
+	assert.Equal(t, "John", contactResponse.Data.FirstName)
+
+	_, err = client.Contacts.DeleteContact(ctx, server.AccountID, contactID)
+	require.NoError(t, err)
+
+	contactsResponse, err := client.Contacts.ListContacts(ctx, server.AccountID, nil)
+	require.NoError(t, err)
+	assert.Empty(t, contactsResponse.Data)
+}
+
+func TestServer_Templates(t *testing.T) {
+	server, client := newTestServer(t)
+	ctx := context.Background()
+
+	_, err := client.Domains.CreateDomain(ctx, server.AccountID, dnsimple.Domain{Name: "example.com"})
+	require.NoError(t, err)
+	_, err = client.Templates.CreateTemplate(ctx, server.AccountID, dnsimple.Template{Name: "Mail", SID: "mail"})
+	require.NoError(t, err)
+	_, err = client.Templates.CreateTemplateRecord(ctx, server.AccountID, "mail", dnsimple.TemplateRecord{Name: "", Type: "MX", Content: "mx.{{domain}}", Priority: 10})
+	require.NoError(t, err)
+
+	templateResponse, err := client.Templates.UpdateTemplate(ctx, server.AccountID, "mail", dnsimple.Template{Description: "Mail records"})
+	require.NoError(t, err)
+	assert.Equal(t, "Mail", templateResponse.Data.Name)
+	assert.Equal(t, "Mail records", templateResponse.Data.Description)
+
+	_, err = client.Templates.ApplyTemplate(ctx, server.AccountID, "mail", "example.com")
+	require.NoError(t, err)
+
+	recordsResponse, err := client.Zones.ListRecords(ctx, server.AccountID, "example.com", &dnsimple.ZoneRecordListOptions{Type: dnsimple.String("MX")})
+	require.NoError(t, err)
+	require.Len(t, recordsResponse.Data, 1)
+	assert.Equal(t, "mx.example.com", recordsResponse.Data[0].Content)
+	assert.Equal(t, 10, recordsResponse.Data[0].Priority)
+
+	_, err = client.Templates.GetTemplate(ctx, server.AccountID, "beta")
+	var errorResponse *dnsimple.ErrorResponse
+	require.ErrorAs(t, err, &errorResponse)
+	assert.Equal(t, "Template `beta` not found", errorResponse.Message)
+}
+
+func TestServer_Webhooks(t *testing.T) {
+	server, client := newTestServer(t)
+	ctx := context.Background()
+
+	webhookResponse, err := client.Webhooks.CreateWebhook(ctx, server.AccountID, dnsimple.Webhook{URL: "https://webhook.test"})
+	require.NoError(t, err)
+
+	webhooksResponse, err := client.Webhooks.ListWebhooks(ctx, server.AccountID, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []dnsimple.Webhook{{ID: webhookResponse.Data.ID, URL: "https://webhook.test"}}, webhooksResponse.Data)
+	assert.Nil(t, webhooksResponse.Pagination)
+
+	_, err = client.Webhooks.DeleteWebhook(ctx, server.AccountID, webhookResponse.Data.ID)
+	require.NoError(t, err)
+
+	_, err = client.Webhooks.GetWebhook(ctx, server.AccountID, webhookResponse.Data.ID)
+	assert.ErrorIs(t, err, dnsimple.ErrNotFound)
+}
+
+func TestServer_NotFound(t *testing.T) {
+	server, _ := newTestServer(t)
+
+	resp, err := server.Server.Client().Get(server.URL + "/v2/unknown")
+
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	assert.Equal(t, "application/json; charset=utf-8", resp.Header.Get("Content-Type"))
+}
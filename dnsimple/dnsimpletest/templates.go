@@ -0,0 +1,223 @@
+package dnsimpletest
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// template is the state of a template.
+type template struct {
+	template templateJSON
+	records  []*templateRecordJSON
+}
+
+// findTemplate returns the template identified by its ID or SID.
+func (a *account) findTemplate(identifier string) (*template, int) {
+	return find(a.templates, func(t *template) bool {
+		return t.template.SID == identifier || strconv.FormatInt(t.template.ID, 10) == identifier
+	})
+}
+
+// withTemplate calls h with the template of the {template} wildcard,
+// or writes a 404 response when it doesn't exist.
+func withTemplate(w http.ResponseWriter, r *http.Request, a *account, h func(t *template, index int)) {
+	t, index := a.findTemplate(r.PathValue("template"))
+	if t == nil {
+		writeNotFound(w, "Template", r.PathValue("template"))
+		return
+	}
+	h(t, index)
+}
+
+// templateAttributes are the attributes of a template create or update request.
+type templateAttributes struct {
+	Name        *string `json:"name"`
+	SID         *string `json:"sid"`
+	Description *string `json:"description"`
+}
+
+// apply sets the attributes of the request to the template.
+func (attributes templateAttributes) apply(t *templateJSON) {
+	if attributes.Name != nil {
+		t.Name = *attributes.Name
+	}
+	if attributes.SID != nil {
+		t.SID = *attributes.SID
+	}
+	if attributes.Description != nil {
+		t.Description = *attributes.Description
+	}
+}
+
+// validateTemplate returns the validation errors of the template.
+func (a *account) validateTemplate(t *templateJSON) validationErrors {
+	errors := validationErrors{}
+	if t.Name == "" {
+		errors.add("name", "can't be blank")
+	}
+	if t.SID == "" {
+		errors.add("sid", "can't be blank")
+	} else if other, _ := a.findTemplate(t.SID); other != nil && other.template.ID != t.ID {
+		errors.add("sid", "has already been taken")
+	}
+	return errors
+}
+
+func (s *Server) listTemplates(w http.ResponseWriter, r *http.Request, a *account) {
+	templates := []templateJSON{}
+	for _, t := range a.templates {
+		templates = append(templates, t.template)
+	}
+	writePage(w, r, templates)
+}
+
+func (s *Server) createTemplate(w http.ResponseWriter, r *http.Request, a *account) {
+	var attributes templateAttributes
+	if !decode(w, r, &attributes) {
+		return
+	}
+
+	var t templateJSON
+	attributes.apply(&t)
+	if errors := a.validateTemplate(&t); len(errors) > 0 {
+		writeValidationErrors(w, errors)
+		return
+	}
+
+	now := s.timestamp()
+	t.ID = s.id()
+	t.AccountID = a.account.ID
+	t.CreatedAt, t.UpdatedAt = now, now
+	a.templates = append(a.templates, &template{template: t})
+
+	writeData(w, http.StatusCreated, t)
+}
+
+func (s *Server) getTemplate(w http.ResponseWriter, r *http.Request, a *account) {
+	withTemplate(w, r, a, func(t *template, _ int) {
+		writeData(w, http.StatusOK, t.template)
+	})
+}
+
+func (s *Server) updateTemplate(w http.ResponseWriter, r *http.Request, a *account) {
+	withTemplate(w, r, a, func(t *template, _ int) {
+		var attributes templateAttributes
+		if !decode(w, r, &attributes) {
+			return
+		}
+
+		updated := t.template
+		attributes.apply(&updated)
+		if errors := a.validateTemplate(&updated); len(errors) > 0 {
+			writeValidationErrors(w, errors)
+			return
+		}
+		updated.UpdatedAt = s.timestamp()
+		t.template = updated
+
+		writeData(w, http.StatusOK, t.template)
+	})
+}
+
+func (s *Server) deleteTemplate(w http.ResponseWriter, r *http.Request, a *account) {
+	withTemplate(w, r, a, func(_ *template, index int) {
+		a.templates = slices.Delete(a.templates, index, index+1)
+		writeNoContent(w)
+	})
+}
+
+// withTemplateRecord calls h with the template record of the {record} wildcard,
+// or writes a 404 response when it doesn't exist.
+func withTemplateRecord(w http.ResponseWriter, r *http.Request, a *account, h func(t *template, record *templateRecordJSON, index int)) {
+	withTemplate(w, r, a, func(t *template, _ int) {
+		id := pathID(r, "record")
+		record, index := find(t.records, func(record *templateRecordJSON) bool { return record.ID == id })
+		if record == nil {
+			writeNotFound(w, "Record", r.PathValue("record"))
+			return
+		}
+		h(t, record, index)
+	})
+}
+
+func (s *Server) listTemplateRecords(w http.ResponseWriter, r *http.Request, a *account) {
+	withTemplate(w, r, a, func(t *template, _ int) {
+		writePage(w, r, t.records)
+	})
+}
+
+func (s *Server) createTemplateRecord(w http.ResponseWriter, r *http.Request, a *account) {
+	withTemplate(w, r, a, func(t *template, _ int) {
+		var attributes recordAttributes
+		if !decode(w, r, &attributes) {
+			return
+		}
+
+		// Template records are validated as zone records.
+		record, errors := s.newRecord(&zone{}, attributes)
+		if errors != nil {
+			writeValidationErrors(w, errors)
+			return
+		}
+		templateRecord := &templateRecordJSON{
+			ID:         record.ID,
+			TemplateID: t.template.ID,
+			Name:       record.Name,
+			Content:    record.Content,
+			TTL:        record.TTL,
+			Priority:   record.Priority,
+			Type:       record.Type,
+			CreatedAt:  record.CreatedAt,
+			UpdatedAt:  record.UpdatedAt,
+		}
+		t.records = append(t.records, templateRecord)
+
+		writeData(w, http.StatusCreated, templateRecord)
+	})
+}
+
+func (s *Server) getTemplateRecord(w http.ResponseWriter, r *http.Request, a *account) {
+	withTemplateRecord(w, r, a, func(_ *template, record *templateRecordJSON, _ int) {
+		writeData(w, http.StatusOK, record)
+	})
+}
+
+func (s *Server) deleteTemplateRecord(w http.ResponseWriter, r *http.Request, a *account) {
+	withTemplateRecord(w, r, a, func(t *template, _ *templateRecordJSON, index int) {
+		t.records = slices.Delete(t.records, index, index+1)
+		writeNoContent(w)
+	})
+}
+
+// applyTemplate creates the records of the template in the zone of the domain.
+// The {{domain}} placeholder of the contents is replaced with the domain name.
+func (s *Server) applyTemplate(w http.ResponseWriter, r *http.Request, a *account) {
+	withDomain(w, r, a, func(d *domain, _ int) {
+		withTemplate(w, r, a, func(t *template, _ int) {
+			z, _ := a.findZone(d.domain.Name)
+			if z == nil {
+				writeNotFound(w, "Zone", d.domain.Name)
+				return
+			}
+
+			now := s.timestamp()
+			for _, templateRecord := range t.records {
+				z.records = append(z.records, &recordJSON{
+					ID:        s.id(),
+					ZoneID:    z.zone.Name,
+					Name:      templateRecord.Name,
+					Content:   strings.ReplaceAll(templateRecord.Content, "{{domain}}", d.domain.Name),
+					TTL:       templateRecord.TTL,
+					Priority:  templateRecord.Priority,
+					Type:      templateRecord.Type,
+					Regions:   []string{"global"},
+					CreatedAt: now,
+					UpdatedAt: now,
+				})
+			}
+			writeNoContent(w)
+		})
+	})
+}
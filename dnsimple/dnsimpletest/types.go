@@ -0,0 +1,146 @@
+package dnsimpletest
+
+// The types below are the JSON representations of the API resources.
+// Unlike the dnsimple types, they don't omit the empty attributes,
+// and encode the missing values as null, as the API does.
+
+type accountJSON struct {
+	ID             int64  `json:"id"`
+	Email          string `json:"email"`
+	Name           string `json:"name"`
+	PlanIdentifier string `json:"plan_identifier"`
+	CreatedAt      string `json:"created_at"`
+	UpdatedAt      string `json:"updated_at"`
+}
+
+type whoamiJSON struct {
+	User    *struct{}    `json:"user"`
+	Account *accountJSON `json:"account"`
+}
+
+type domainJSON struct {
+	ID           int64   `json:"id"`
+	AccountID    int64   `json:"account_id"`
+	RegistrantID *int64  `json:"registrant_id"`
+	Name         string  `json:"name"`
+	UnicodeName  string  `json:"unicode_name"`
+	State        string  `json:"state"`
+	AutoRenew    bool    `json:"auto_renew"`
+	PrivateWhois bool    `json:"private_whois"`
+	ExpiresOn    *string `json:"expires_on"`
+	ExpiresAt    *string `json:"expires_at"`
+	CreatedAt    string  `json:"created_at"`
+	UpdatedAt    string  `json:"updated_at"`
+}
+
+type emailForwardJSON struct {
+	ID               int64  `json:"id"`
+	DomainID         int64  `json:"domain_id"`
+	AliasEmail       string `json:"alias_email"`
+	DestinationEmail string `json:"destination_email"`
+	CreatedAt        string `json:"created_at"`
+	UpdatedAt        string `json:"updated_at"`
+	Active           bool   `json:"active"`
+}
+
+type zoneJSON struct {
+	ID                int64   `json:"id"`
+	AccountID         int64   `json:"account_id"`
+	Name              string  `json:"name"`
+	Reverse           bool    `json:"reverse"`
+	Secondary         bool    `json:"secondary"`
+	LastTransferredAt *string `json:"last_transferred_at"`
+	Active            bool    `json:"active"`
+	CreatedAt         string  `json:"created_at"`
+	UpdatedAt         string  `json:"updated_at"`
+}
+
+type zoneFileJSON struct {
+	Zone string `json:"zone"`
+}
+
+type recordJSON struct {
+	ID           int64    `json:"id"`
+	ZoneID       string   `json:"zone_id"`
+	ParentID     *int64   `json:"parent_id"`
+	Name         string   `json:"name"`
+	Content      string   `json:"content"`
+	TTL          int      `json:"ttl"`
+	Priority     *int     `json:"priority"`
+	Type         string   `json:"type"`
+	Regions      []string `json:"regions"`
+	SystemRecord bool     `json:"system_record"`
+	CreatedAt    string   `json:"created_at"`
+	UpdatedAt    string   `json:"updated_at"`
+}
+
+type recordIDJSON struct {
+	ID int64 `json:"id"`
+}
+
+type batchChangeJSON struct {
+	Creates []*recordJSON  `json:"creates,omitempty"`
+	Updates []*recordJSON  `json:"updates,omitempty"`
+	Deletes []recordIDJSON `json:"deletes,omitempty"`
+}
+
+type batchErrorJSON struct {
+	Index   int              `json:"index"`
+	Message string           `json:"message"`
+	Errors  validationErrors `json:"errors,omitempty"`
+}
+
+type batchErrorsJSON struct {
+	Creates []batchErrorJSON `json:"creates,omitempty"`
+	Updates []batchErrorJSON `json:"updates,omitempty"`
+	Deletes []batchErrorJSON `json:"deletes,omitempty"`
+}
+
+type contactJSON struct {
+	ID            int64  `json:"id"`
+	AccountID     int64  `json:"account_id"`
+	Label         string `json:"label"`
+	FirstName     string `json:"first_name"`
+	LastName      string `json:"last_name"`
+	JobTitle      string `json:"job_title"`
+	Organization  string `json:"organization_name"`
+	Email         string `json:"email"`
+	Phone         string `json:"phone"`
+	Fax           string `json:"fax"`
+	Address1      string `json:"address1"`
+	Address2      string `json:"address2"`
+	City          string `json:"city"`
+	StateProvince string `json:"state_province"`
+	PostalCode    string `json:"postal_code"`
+	Country       string `json:"country"`
+	CreatedAt     string `json:"created_at"`
+	UpdatedAt     string `json:"updated_at"`
+}
+
+type templateJSON struct {
+	ID          int64  `json:"id"`
+	AccountID   int64  `json:"account_id"`
+	Name        string `json:"name"`
+	SID         string `json:"sid"`
+	Description string `json:"description"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+type templateRecordJSON struct {
+	ID         int64  `json:"id"`
+	TemplateID int64  `json:"template_id"`
+	Name       string `json:"name"`
+	Content    string `json:"content"`
+	TTL        int    `json:"ttl"`
+	Priority   *int   `json:"priority"`
+	Type       string `json:"type"`
+	CreatedAt  string `json:"created_at"`
+	UpdatedAt  string `json:"updated_at"`
+}
+
+type webhookJSON struct {
+	ID           int64   `json:"id"`
+	URL          string  `json:"url"`
+	SuppressedAt *string `json:"suppressed_at"`
+}
@@ -0,0 +1,58 @@
+package dnsimpletest
+
+import (
+	"net/http"
+	"slices"
+)
+
+// withWebhook calls h with the webhook of the {webhook} wildcard,
+// or writes a 404 response when it doesn't exist.
+func withWebhook(w http.ResponseWriter, r *http.Request, a *account, h func(webhook *webhookJSON, index int)) {
+	id := pathID(r, "webhook")
+	webhook, index := find(a.webhooks, func(webhook *webhookJSON) bool { return webhook.ID == id })
+	if webhook == nil {
+		writeNotFound(w, "Webhook", r.PathValue("webhook"))
+		return
+	}
+	h(webhook, index)
+}
+
+// listWebhooks lists the webhooks. Like the API, it doesn't paginate them.
+func (s *Server) listWebhooks(w http.ResponseWriter, r *http.Request, a *account) {
+	webhooks := a.webhooks
+	if webhooks == nil {
+		webhooks = []*webhookJSON{}
+	}
+	writeData(w, http.StatusOK, webhooks)
+}
+
+func (s *Server) createWebhook(w http.ResponseWriter, r *http.Request, a *account) {
+	var attributes struct {
+		URL string `json:"url"`
+	}
+	if !decode(w, r, &attributes) {
+		return
+	}
+	if attributes.URL == "" {
+		writeValidationErrors(w, validationErrors{"url": {"can't be blank"}})
+		return
+	}
+
+	webhook := &webhookJSON{ID: s.id(), URL: attributes.URL}
+	a.webhooks = append(a.webhooks, webhook)
+
+	writeData(w, http.StatusCreated, webhook)
+}
+
+func (s *Server) getWebhook(w http.ResponseWriter, r *http.Request, a *account) {
+	withWebhook(w, r, a, func(webhook *webhookJSON, _ int) {
+		writeData(w, http.StatusOK, webhook)
+	})
+}
+
+func (s *Server) deleteWebhook(w http.ResponseWriter, r *http.Request, a *account) {
+	withWebhook(w, r, a, func(_ *webhookJSON, index int) {
+		a.webhooks = slices.Delete(a.webhooks, index, index+1)
+		writeNoContent(w)
+	})
+}
@@ -0,0 +1,165 @@
+package dnsimpletest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// nameServers are the name servers of the zones created with a domain.
+var nameServers = []string{"ns1.dnsimple.com", "ns2.dnsimple.com", "ns3.dnsimple.com", "ns4.dnsimple.com"}
+
+// zone is the state of a zone.
+type zone struct {
+	zone    zoneJSON
+	records []*recordJSON
+}
+
+// findZone returns the zone identified by its ID or name.
+func (a *account) findZone(identifier string) (*zone, int) {
+	return find(a.zones, func(z *zone) bool {
+		return z.zone.Name == identifier || strconv.FormatInt(z.zone.ID, 10) == identifier
+	})
+}
+
+// withZone calls h with the zone of the {zone} wildcard,
+// or writes a 404 response when it doesn't exist.
+func withZone(w http.ResponseWriter, r *http.Request, a *account, h func(z *zone)) {
+	z, _ := a.findZone(r.PathValue("zone"))
+	if z == nil {
+		writeNotFound(w, "Zone", r.PathValue("zone"))
+		return
+	}
+	h(z)
+}
+
+// createZone creates the zone of a domain, with its SOA and NS system records.
+func (s *Server) createZone(a *account, name string) *zone {
+	now := s.timestamp()
+	z := &zone{zone: zoneJSON{
+		ID:        s.id(),
+		AccountID: a.account.ID,
+		Name:      name,
+		Reverse:   strings.HasSuffix(name, ".arpa"),
+		Active:    true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}}
+
+	soa := fmt.Sprintf("%s admin.dnsimple.com %d 86400 7200 604800 300", nameServers[0], s.now().Unix())
+	z.records = append(z.records, s.systemRecord(name, "SOA", soa))
+	for _, ns := range nameServers {
+		z.records = append(z.records, s.systemRecord(name, "NS", ns))
+	}
+
+	a.zones = append(a.zones, z)
+	return z
+}
+
+// systemRecord returns a new apex system record of the zone.
+func (s *Server) systemRecord(zoneName, recordType, content string) *recordJSON {
+	now := s.timestamp()
+	return &recordJSON{
+		ID:           s.id(),
+		ZoneID:       zoneName,
+		Content:      content,
+		TTL:          3600,
+		Type:         recordType,
+		Regions:      []string{"global"},
+		SystemRecord: true,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+}
+
+func (s *Server) listZones(w http.ResponseWriter, r *http.Request, a *account) {
+	nameLike := r.URL.Query().Get("name_like")
+
+	zones := []zoneJSON{}
+	for _, z := range a.zones {
+		if strings.Contains(z.zone.Name, nameLike) {
+			zones = append(zones, z.zone)
+		}
+	}
+	writePage(w, r, zones)
+}
+
+func (s *Server) getZone(w http.ResponseWriter, r *http.Request, a *account) {
+	withZone(w, r, a, func(z *zone) {
+		writeData(w, http.StatusOK, z.zone)
+	})
+}
+
+func (s *Server) getZoneFile(w http.ResponseWriter, r *http.Request, a *account) {
+	withZone(w, r, a, func(z *zone) {
+		writeData(w, http.StatusOK, zoneFileJSON{Zone: zoneFile(z)})
+	})
+}
+
+func (s *Server) activateZone(w http.ResponseWriter, r *http.Request, a *account) {
+	withZone(w, r, a, func(z *zone) {
+		z.zone.Active = true
+		z.zone.UpdatedAt = s.timestamp()
+		writeData(w, http.StatusOK, z.zone)
+	})
+}
+
+func (s *Server) deactivateZone(w http.ResponseWriter, r *http.Request, a *account) {
+	withZone(w, r, a, func(z *zone) {
+		z.zone.Active = false
+		z.zone.UpdatedAt = s.timestamp()
+		writeData(w, http.StatusOK, z.zone)
+	})
+}
+
+// zoneFile returns the records of the zone in the zone file format.
+func zoneFile(z *zone) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "$ORIGIN %s.\n$TTL 1h\n", z.zone.Name)
+	for _, record := range z.records {
+		name := z.zone.Name + "."
+		if record.Name != "" {
+			name = record.Name + "." + name
+		}
+
+		content := record.Content
+		switch record.Type {
+		case "SOA":
+			fields := strings.Fields(content)
+			if len(fields) > 2 {
+				fields[0], fields[1] = fqdn(fields[0]), fqdn(fields[1])
+			}
+			content = strings.Join(fields, " ")
+		case "NS", "CNAME", "ALIAS", "PTR":
+			content = fqdn(content)
+		case "MX":
+			content = fmt.Sprintf("%d %s", priority(record), fqdn(content))
+		case "SRV":
+			content = fmt.Sprintf("%d %s", priority(record), content)
+		case "TXT", "SPF":
+			if !strings.HasPrefix(content, `"`) {
+				content = strconv.Quote(content)
+			}
+		}
+
+		fmt.Fprintf(&b, "%s %d IN %s %s\n", name, record.TTL, record.Type, content)
+	}
+	return b.String()
+}
+
+// fqdn returns the name with a trailing dot.
+func fqdn(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
+
+// priority returns the priority of the record, or 0 when it has none.
+func priority(record *recordJSON) int {
+	if record.Priority == nil {
+		return 0
+	}
+	return *record.Priority
+}
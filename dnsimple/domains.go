@@ -3,6 +3,7 @@ package dnsimple
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 // DomainsService handles communication with the domain related
@@ -74,7 +75,7 @@ func (s *DomainsService) ListDomains(ctx context.Context, accountID string, opti
 		return nil, err
 	}
 
-	resp, err := s.client.get(ctx, path, domainsResponse)
+	resp, err := s.client.get(ctx, "Domains.ListDomains", path, domainsResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -83,6 +84,28 @@ func (s *DomainsService) ListDomains(ctx context.Context, accountID string, opti
 	return domainsResponse, nil
 }
 
+// ListDomainsIter returns an iterator over the domains for an account,
+// that fetches the pages lazily as the iteration progresses.
+//
+// See ListDomains for the available options, and All to collect the results.
+func (s *DomainsService) ListDomainsIter(ctx context.Context, accountID string, options *DomainListOptions) iter.Seq2[Domain, error] {
+	opts := DomainListOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	return paginate(ctx, s.client, firstPage(&opts.ListOptions), func(ctx context.Context, page int) ([]Domain, *Response, error) {
+		opts := opts
+		opts.Page = &page
+
+		resp, err := s.ListDomains(ctx, accountID, &opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Data, &resp.Response, nil
+	})
+}
+
 // CreateDomain creates a new domain in the account.
 //
 // See https://developer.dnsimple.com/v2/domains/#create
@@ -90,7 +113,7 @@ func (s *DomainsService) CreateDomain(ctx context.Context, accountID string, dom
 	path := versioned(domainPath(accountID, ""))
 	domainResponse := &DomainResponse{}
 
-	resp, err := s.client.post(ctx, path, domainAttributes, domainResponse)
+	resp, err := s.client.post(ctx, "Domains.CreateDomain", path, domainAttributes, domainResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -106,7 +129,7 @@ func (s *DomainsService) GetDomain(ctx context.Context, accountID string, domain
 	path := versioned(domainPath(accountID, domainIdentifier))
 	domainResponse := &DomainResponse{}
 
-	resp, err := s.client.get(ctx, path, domainResponse)
+	resp, err := s.client.get(ctx, "Domains.GetDomain", path, domainResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -122,7 +145,7 @@ func (s *DomainsService) DeleteDomain(ctx context.Context, accountID string, dom
 	path := versioned(domainPath(accountID, domainIdentifier))
 	domainResponse := &DomainResponse{}
 
-	resp, err := s.client.delete(ctx, path, nil, nil)
+	resp, err := s.client.delete(ctx, "Domains.DeleteDomain", path, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -152,7 +175,7 @@ func (s *DomainsService) GetDomainResearchStatus(ctx context.Context, accountID
 	path := versioned(fmt.Sprintf("/%v/domains/research/status?domain=%v", accountID, domainName))
 	researchStatusResponse := &DomainResearchStatusResponse{}
 
-	resp, err := s.client.get(ctx, path, researchStatusResponse)
+	resp, err := s.client.get(ctx, "Domains.GetDomainResearchStatus", path, researchStatusResponse)
 	if err != nil {
 		return nil, err
 	}
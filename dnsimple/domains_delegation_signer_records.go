@@ -3,6 +3,7 @@ package dnsimple
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 // DelegationSignerRecord represents a delegation signer record for a domain in DNSimple.
@@ -50,7 +51,7 @@ func (s *DomainsService) ListDelegationSignerRecords(ctx context.Context, accoun
 		return nil, err
 	}
 
-	resp, err := s.client.get(ctx, path, dsRecordsResponse)
+	resp, err := s.client.get(ctx, "Domains.ListDelegationSignerRecords", path, dsRecordsResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -59,6 +60,28 @@ func (s *DomainsService) ListDelegationSignerRecords(ctx context.Context, accoun
 	return dsRecordsResponse, nil
 }
 
+// ListDelegationSignerRecordsIter returns an iterator over the delegation signer records for a domain,
+// that fetches the pages lazily as the iteration progresses.
+//
+// See ListDelegationSignerRecords for the available options, and All to collect the results.
+func (s *DomainsService) ListDelegationSignerRecordsIter(ctx context.Context, accountID string, domainIdentifier string, options *ListOptions) iter.Seq2[DelegationSignerRecord, error] {
+	opts := ListOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	return paginate(ctx, s.client, firstPage(&opts), func(ctx context.Context, page int) ([]DelegationSignerRecord, *Response, error) {
+		opts := opts
+		opts.Page = &page
+
+		resp, err := s.ListDelegationSignerRecords(ctx, accountID, domainIdentifier, &opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Data, &resp.Response, nil
+	})
+}
+
 // CreateDelegationSignerRecord creates a new delegation signer record.
 //
 // See https://developer.dnsimple.com/v2/domains/dnssec/#ds-record-create
@@ -66,7 +89,7 @@ func (s *DomainsService) CreateDelegationSignerRecord(ctx context.Context, accou
 	path := versioned(delegationSignerRecordPath(accountID, domainIdentifier, 0))
 	dsRecordResponse := &DelegationSignerRecordResponse{}
 
-	resp, err := s.client.post(ctx, path, dsRecordAttributes, dsRecordResponse)
+	resp, err := s.client.post(ctx, "Domains.CreateDelegationSignerRecord", path, dsRecordAttributes, dsRecordResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -82,7 +105,7 @@ func (s *DomainsService) GetDelegationSignerRecord(ctx context.Context, accountI
 	path := versioned(delegationSignerRecordPath(accountID, domainIdentifier, dsRecordID))
 	dsRecordResponse := &DelegationSignerRecordResponse{}
 
-	resp, err := s.client.get(ctx, path, dsRecordResponse)
+	resp, err := s.client.get(ctx, "Domains.GetDelegationSignerRecord", path, dsRecordResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -99,7 +122,7 @@ func (s *DomainsService) DeleteDelegationSignerRecord(ctx context.Context, accou
 	path := versioned(delegationSignerRecordPath(accountID, domainIdentifier, dsRecordID))
 	dsRecordResponse := &DelegationSignerRecordResponse{}
 
-	resp, err := s.client.delete(ctx, path, nil, nil)
+	resp, err := s.client.delete(ctx, "Domains.DeleteDelegationSignerRecord", path, nil, nil)
 	if err != nil {
 		return nil, err
 	}
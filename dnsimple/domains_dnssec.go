@@ -28,7 +28,7 @@ func (s *DomainsService) EnableDnssec(ctx context.Context, accountID string, dom
 	path := versioned(dnssecPath(accountID, domainIdentifier))
 	dnssecResponse := &DnssecResponse{}
 
-	resp, err := s.client.post(ctx, path, nil, dnssecResponse)
+	resp, err := s.client.post(ctx, "Domains.EnableDnssec", path, nil, dnssecResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -44,7 +44,7 @@ func (s *DomainsService) DisableDnssec(ctx context.Context, accountID string, do
 	path := versioned(dnssecPath(accountID, domainIdentifier))
 	dnssecResponse := &DnssecResponse{}
 
-	resp, err := s.client.delete(ctx, path, nil, nil)
+	resp, err := s.client.delete(ctx, "Domains.DisableDnssec", path, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -60,7 +60,7 @@ func (s *DomainsService) GetDnssec(ctx context.Context, accountID string, domain
 	path := versioned(dnssecPath(accountID, domainIdentifier))
 	dnssecResponse := &DnssecResponse{}
 
-	resp, err := s.client.get(ctx, path, dnssecResponse)
+	resp, err := s.client.get(ctx, "Domains.GetDnssec", path, dnssecResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -3,6 +3,7 @@ package dnsimple
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 // EmailForward represents an email forward in DNSimple.
@@ -51,7 +52,7 @@ func (s *DomainsService) ListEmailForwards(ctx context.Context, accountID string
 		return nil, err
 	}
 
-	resp, err := s.client.get(ctx, path, forwardsResponse)
+	resp, err := s.client.get(ctx, "Domains.ListEmailForwards", path, forwardsResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -60,6 +61,28 @@ func (s *DomainsService) ListEmailForwards(ctx context.Context, accountID string
 	return forwardsResponse, nil
 }
 
+// ListEmailForwardsIter returns an iterator over the email forwards for a domain,
+// that fetches the pages lazily as the iteration progresses.
+//
+// See ListEmailForwards for the available options, and All to collect the results.
+func (s *DomainsService) ListEmailForwardsIter(ctx context.Context, accountID string, domainIdentifier string, options *ListOptions) iter.Seq2[EmailForward, error] {
+	opts := ListOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	return paginate(ctx, s.client, firstPage(&opts), func(ctx context.Context, page int) ([]EmailForward, *Response, error) {
+		opts := opts
+		opts.Page = &page
+
+		resp, err := s.ListEmailForwards(ctx, accountID, domainIdentifier, &opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Data, &resp.Response, nil
+	})
+}
+
 // CreateEmailForward creates a new email forward.
 //
 // See https://developer.dnsimple.com/v2/domains/email-forwards/#create
@@ -67,7 +90,7 @@ func (s *DomainsService) CreateEmailForward(ctx context.Context, accountID strin
 	path := versioned(emailForwardPath(accountID, domainIdentifier, 0))
 	forwardResponse := &EmailForwardResponse{}
 
-	resp, err := s.client.post(ctx, path, forwardAttributes, forwardResponse)
+	resp, err := s.client.post(ctx, "Domains.CreateEmailForward", path, forwardAttributes, forwardResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -83,7 +106,7 @@ func (s *DomainsService) GetEmailForward(ctx context.Context, accountID string,
 	path := versioned(emailForwardPath(accountID, domainIdentifier, forwardID))
 	forwardResponse := &EmailForwardResponse{}
 
-	resp, err := s.client.get(ctx, path, forwardResponse)
+	resp, err := s.client.get(ctx, "Domains.GetEmailForward", path, forwardResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -99,7 +122,7 @@ func (s *DomainsService) DeleteEmailForward(ctx context.Context, accountID strin
 	path := versioned(emailForwardPath(accountID, domainIdentifier, forwardID))
 	forwardResponse := &EmailForwardResponse{}
 
-	resp, err := s.client.delete(ctx, path, nil, nil)
+	resp, err := s.client.delete(ctx, "Domains.DeleteEmailForward", path, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3,6 +3,7 @@ package dnsimple
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 // DomainPush represents a domain push in DNSimple.
@@ -51,7 +52,7 @@ func (s *DomainsService) InitiatePush(ctx context.Context, accountID, domainID s
 	path := versioned(fmt.Sprintf("/%v/pushes", domainPath(accountID, domainID)))
 	pushResponse := &DomainPushResponse{}
 
-	resp, err := s.client.post(ctx, path, pushAttributes, pushResponse)
+	resp, err := s.client.post(ctx, "Domains.InitiatePush", path, pushAttributes, pushResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -72,7 +73,7 @@ func (s *DomainsService) ListPushes(ctx context.Context, accountID string, optio
 		return nil, err
 	}
 
-	resp, err := s.client.get(ctx, path, pushesResponse)
+	resp, err := s.client.get(ctx, "Domains.ListPushes", path, pushesResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -81,6 +82,28 @@ func (s *DomainsService) ListPushes(ctx context.Context, accountID string, optio
 	return pushesResponse, nil
 }
 
+// ListPushesIter returns an iterator over the pushes for an account,
+// that fetches the pages lazily as the iteration progresses.
+//
+// See ListPushes for the available options, and All to collect the results.
+func (s *DomainsService) ListPushesIter(ctx context.Context, accountID string, options *ListOptions) iter.Seq2[DomainPush, error] {
+	opts := ListOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	return paginate(ctx, s.client, firstPage(&opts), func(ctx context.Context, page int) ([]DomainPush, *Response, error) {
+		opts := opts
+		opts.Page = &page
+
+		resp, err := s.ListPushes(ctx, accountID, &opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Data, &resp.Response, nil
+	})
+}
+
 // AcceptPush accept a push for a domain.
 //
 // See https://developer.dnsimple.com/v2/domains/pushes/#acceptPush
@@ -88,7 +111,7 @@ func (s *DomainsService) AcceptPush(ctx context.Context, accountID string, pushI
 	path := versioned(domainPushPath(accountID, pushID))
 	pushResponse := &DomainPushResponse{}
 
-	resp, err := s.client.post(ctx, path, pushAttributes, nil)
+	resp, err := s.client.post(ctx, "Domains.AcceptPush", path, pushAttributes, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -104,7 +127,7 @@ func (s *DomainsService) RejectPush(ctx context.Context, accountID string, pushI
 	path := versioned(domainPushPath(accountID, pushID))
 	pushResponse := &DomainPushResponse{}
 
-	resp, err := s.client.delete(ctx, path, nil, nil)
+	resp, err := s.client.delete(ctx, "Domains.RejectPush", path, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,66 @@
+package dnsimple
+
+import (
+	"errors"
+	"net/http"
+)
+
+// Sentinel errors that classify the ErrorResponse returned by the API calls,
+// according to the HTTP status code of the response.
+//
+// Use errors.Is to test an error against them, and errors.As to access the ErrorResponse:
+//
+//	_, err := client.Domains.GetDomain(ctx, accountID, "example.com")
+//	if errors.Is(err, dnsimple.ErrNotFound) {
+//		// the domain doesn't exist
+//	}
+var (
+	// ErrUnauthorized classifies 401 Unauthorized responses.
+	ErrUnauthorized = errors.New("dnsimple: unauthorized")
+
+	// ErrForbidden classifies 403 Forbidden responses.
+	ErrForbidden = errors.New("dnsimple: forbidden")
+
+	// ErrNotFound classifies 404 Not Found responses.
+	ErrNotFound = errors.New("dnsimple: not found")
+
+	// ErrConflict classifies 409 Conflict responses.
+	ErrConflict = errors.New("dnsimple: conflict")
+
+	// ErrValidation classifies 400 Bad Request and 422 Unprocessable Entity responses,
+	// that the API uses to report validation errors.
+	ErrValidation = errors.New("dnsimple: validation failed")
+
+	// ErrRateLimited classifies 429 Too Many Requests responses.
+	ErrRateLimited = errors.New("dnsimple: rate limited")
+
+	// ErrServerError classifies 5xx responses.
+	ErrServerError = errors.New("dnsimple: server error")
+)
+
+// Is reports whether the error matches the target sentinel error,
+// according to the HTTP status code of the response.
+func (r *ErrorResponse) Is(target error) bool {
+	if r.HTTPResponse == nil {
+		return false
+	}
+
+	code := r.HTTPResponse.StatusCode
+	switch target {
+	case ErrUnauthorized:
+		return code == http.StatusUnauthorized
+	case ErrForbidden:
+		return code == http.StatusForbidden
+	case ErrNotFound:
+		return code == http.StatusNotFound
+	case ErrConflict:
+		return code == http.StatusConflict
+	case ErrValidation:
+		return code == http.StatusBadRequest || code == http.StatusUnprocessableEntity
+	case ErrRateLimited:
+		return code == http.StatusTooManyRequests
+	case ErrServerError:
+		return code >= 500 && code <= 599
+	}
+	return false
+}
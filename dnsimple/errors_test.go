@@ -0,0 +1,110 @@
+package dnsimple
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var sentinelErrors = []error{
+	ErrUnauthorized,
+	ErrForbidden,
+	ErrNotFound,
+	ErrConflict,
+	ErrValidation,
+	ErrRateLimited,
+	ErrServerError,
+}
+
+func TestErrorResponse_Is(t *testing.T) {
+	tests := []struct {
+		code int
+		want error
+	}{
+		{http.StatusBadRequest, ErrValidation},
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusForbidden, ErrForbidden},
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusMethodNotAllowed, nil},
+		{http.StatusConflict, ErrConflict},
+		{http.StatusUnprocessableEntity, ErrValidation},
+		{http.StatusTooManyRequests, ErrRateLimited},
+		{http.StatusInternalServerError, ErrServerError},
+		{http.StatusBadGateway, ErrServerError},
+	}
+
+	for _, tt := range tests {
+		resp := &http.Response{StatusCode: tt.code, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(`{"message":"error"}`))}
+		err := CheckResponse(resp)
+
+		for _, sentinel := range sentinelErrors {
+			assert.Equal(t, sentinel == tt.want, errors.Is(err, sentinel), "%d %v", tt.code, sentinel)
+		}
+	}
+}
+
+func TestCheckResponse_RequestIDAndBody(t *testing.T) {
+	err := CheckResponse(httpResponseFixture(t, "/api/notfound-domain.http"))
+
+	assert.ErrorIs(t, err, ErrNotFound)
+	var got *ErrorResponse
+	assert.ErrorAs(t, err, &got)
+	assert.Equal(t, "bc587ea7-bcd5-4c10-a940-a9b4c8339824", got.RequestID)
+	assert.JSONEq(t, `{"message":"Domain `+"`"+`0`+"`"+` not found"}`, string(got.Body))
+}
+
+func TestCheckResponse_NotJSON(t *testing.T) {
+	resp := &http.Response{
+		StatusCode: http.StatusBadGateway,
+		Header:     http.Header{"Content-Type": {"text/html"}},
+		Body:       io.NopCloser(strings.NewReader("<html><body><h1>502 Bad Gateway</h1></body></html>")),
+	}
+	err := CheckResponse(resp)
+
+	assert.ErrorIs(t, err, ErrServerError)
+	var got *ErrorResponse
+	assert.ErrorAs(t, err, &got)
+	assert.Contains(t, string(got.Body), "502 Bad Gateway")
+
+	err = CheckResponse(httpResponseFixture(t, "/api/method-not-allowed.http"))
+
+	assert.ErrorAs(t, err, &got)
+	assert.Equal(t, http.StatusMethodNotAllowed, got.HTTPResponse.StatusCode)
+	assert.Equal(t, "64c0a5e1-4cbb-4287-98a7-93085a77ac55", got.RequestID)
+	assert.Empty(t, got.Body)
+}
+
+func TestCheckResponse_Forbidden(t *testing.T) {
+	err := CheckResponse(httpResponseFixture(t, "/api/listCharges/fail-403.http"))
+
+	assert.ErrorIs(t, err, ErrForbidden)
+	assert.NotErrorIs(t, err, ErrNotFound)
+}
+
+func TestCheckResponse_StringMapErrors(t *testing.T) {
+	resp := &http.Response{
+		StatusCode: http.StatusBadRequest,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader(`{"message":"Validation failed","errors":{"name":"can't be blank"}}`)),
+	}
+	err := CheckResponse(resp)
+
+	var got *ErrorResponse
+	assert.ErrorAs(t, err, &got)
+	assert.Equal(t, "Validation failed", got.Message)
+	assert.Equal(t, map[string][]string{"name": {"can't be blank"}}, got.AttributeErrors)
+}
+
+func TestIsErrorsFieldTypeError(t *testing.T) {
+	assert.True(t, isErrorsFieldTypeError(&json.UnmarshalTypeError{Field: "errors"}))
+	assert.True(t, isErrorsFieldTypeError(fmt.Errorf("wrapped: %w", &json.UnmarshalTypeError{Field: "errors.name"})))
+	assert.False(t, isErrorsFieldTypeError(&json.UnmarshalTypeError{Field: "errorsx"}))
+	assert.False(t, isErrorsFieldTypeError(&json.UnmarshalTypeError{Field: "data.errors"}))
+	assert.False(t, isErrorsFieldTypeError(errors.New("other")))
+}
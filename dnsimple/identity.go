@@ -32,7 +32,7 @@ func (s *IdentityService) Whoami(ctx context.Context) (*WhoamiResponse, error) {
 	path := versioned("/whoami")
 	whoamiResponse := &WhoamiResponse{}
 
-	resp, err := s.client.get(ctx, path, whoamiResponse)
+	resp, err := s.client.get(ctx, "Identity.Whoami", path, whoamiResponse)
 	if err != nil {
 		return nil, err
 	}
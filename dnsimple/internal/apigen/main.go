@@ -0,0 +1,408 @@
+// Command apigen generates the service interfaces of the dnsimple package,
+// and their fakes in the dnsimplemock package, from the methods of the services.
+//
+// It runs in the dnsimple package directory:
+//
+//	go generate ./dnsimple
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// services are the services of the client, in the order of the Client fields.
+var services = []string{
+	"Identity",
+	"Accounts",
+	"Billing",
+	"Certificates",
+	"Contacts",
+	"Domains",
+	"DnsAnalytics",
+	"Oauth",
+	"Registrar",
+	"Services",
+	"Templates",
+	"Tlds",
+	"VanityNameServers",
+	"Webhooks",
+	"Zones",
+}
+
+// method is an exported method of a service.
+type method struct {
+	name string
+	typ  *ast.FuncType
+	file *ast.File
+}
+
+func main() {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go") && info.Name() != "api.go"
+	}, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	pkg, ok := pkgs["dnsimple"]
+	if !ok {
+		log.Fatal("apigen: must run in the dnsimple package directory")
+	}
+
+	methods := map[string][]method{}
+	types := map[string]bool{}
+	names := make([]string, 0, len(pkg.Files))
+	for name := range pkg.Files {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		file := pkg.Files[name]
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					if spec, ok := spec.(*ast.TypeSpec); ok {
+						types[spec.Name.Name] = true
+					}
+				}
+			case *ast.FuncDecl:
+				if decl.Recv == nil || !decl.Name.IsExported() {
+					continue
+				}
+				star, ok := decl.Recv.List[0].Type.(*ast.StarExpr)
+				if !ok {
+					continue
+				}
+				receiver := star.X.(*ast.Ident).Name
+				methods[receiver] = append(methods[receiver], method{name: decl.Name.Name, typ: decl.Type, file: file})
+			}
+		}
+	}
+
+	g := &generator{types: types, methods: methods}
+	g.write("api.go", g.interfaces())
+	g.write(filepath.Join("dnsimplemock", "dnsimplemock.go"), g.mocks())
+}
+
+type generator struct {
+	types   map[string]bool
+	methods map[string][]method
+}
+
+// write formats and writes the source to the file.
+func (g *generator) write(path string, src []byte) {
+	formatted, err := format.Source(src)
+	if err != nil {
+		log.Fatalf("apigen: formatting %s: %v\n%s", path, err, src)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(path, formatted, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// interfaces returns the source of the interfaces of the services.
+func (g *generator) interfaces() []byte {
+	var b bytes.Buffer
+	imports := map[string]string{}
+	for _, service := range services {
+		for _, m := range g.methods[service+"Service"] {
+			g.collectImports(m, imports)
+		}
+	}
+
+	fmt.Fprintln(&b, "// Code generated by apigen. DO NOT EDIT.")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "package dnsimple")
+	fmt.Fprintln(&b)
+	writeImports(&b, imports)
+	fmt.Fprintln(&b, "//go:generate go run ./internal/apigen")
+	fmt.Fprintln(&b)
+
+	for _, service := range services {
+		fmt.Fprintf(&b, "// %sAPI is the interface of %sService, to depend on in place of the concrete service.\n", service, service)
+		fmt.Fprintf(&b, "// See the dnsimplemock package for a fake implementation.\n")
+		fmt.Fprintf(&b, "type %sAPI interface {\n", service)
+		for _, m := range g.methods[service+"Service"] {
+			fmt.Fprintf(&b, "%s%s\n", m.name, strings.TrimPrefix(g.print(m.typ), "func"))
+		}
+		fmt.Fprintln(&b, "}")
+		fmt.Fprintln(&b)
+	}
+
+	fmt.Fprintln(&b, "var (")
+	for _, service := range services {
+		fmt.Fprintf(&b, "_ %sAPI = (*%sService)(nil)\n", service, service)
+	}
+	fmt.Fprintln(&b, ")")
+	return b.Bytes()
+}
+
+// mocks returns the source of the fakes of the services.
+func (g *generator) mocks() []byte {
+	var b bytes.Buffer
+	imports := map[string]string{
+		"dnsimple": "github.com/dnsimple/dnsimple-go/v9/dnsimple",
+		"sync":     "sync",
+		"fmt":      "fmt",
+	}
+
+	var body bytes.Buffer
+	for _, service := range services {
+		g.writeMock(&body, service, imports)
+	}
+
+	fmt.Fprintln(&b, "// Code generated by apigen. DO NOT EDIT.")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "package dnsimplemock")
+	fmt.Fprintln(&b)
+	writeImports(&b, imports)
+	b.Write(body.Bytes())
+
+	fmt.Fprintln(&b, "var (")
+	for _, service := range services {
+		fmt.Fprintf(&b, "_ dnsimple.%sAPI = (*%sAPI)(nil)\n", service, service)
+	}
+	fmt.Fprintln(&b, ")")
+	return b.Bytes()
+}
+
+// writeMock writes the fake of the service.
+func (g *generator) writeMock(b *bytes.Buffer, service string, imports map[string]string) {
+	name := service + "API"
+	methods := g.methods[service+"Service"]
+
+	fmt.Fprintf(b, "// %s is a fake dnsimple.%s.\n", name, name)
+	fmt.Fprintf(b, "// Each method calls the function of the matching field, and records the call.\n")
+	fmt.Fprintf(b, "// When the field is nil, the method returns ErrNotImplemented.\n")
+	fmt.Fprintf(b, "type %s struct {\n", name)
+	for _, m := range methods {
+		g.collectImports(m, imports)
+		qualified := g.qualify(m.typ)
+		fmt.Fprintf(b, "%sFunc %s\n", m.name, g.print(qualified))
+	}
+	fmt.Fprintln(b)
+	fmt.Fprintln(b, "mu sync.Mutex")
+	fmt.Fprintln(b, "calls []Call")
+	fmt.Fprintln(b, "}")
+	fmt.Fprintln(b)
+
+	fmt.Fprintf(b, "// Calls returns the calls made to the fake, in order.\n")
+	fmt.Fprintf(b, "func (m *%s) Calls() []Call {\n", name)
+	fmt.Fprintln(b, "m.mu.Lock()")
+	fmt.Fprintln(b, "defer m.mu.Unlock()")
+	fmt.Fprintln(b, "return append([]Call(nil), m.calls...)")
+	fmt.Fprintln(b, "}")
+	fmt.Fprintln(b)
+
+	for _, m := range methods {
+		qualified := g.qualify(m.typ)
+		args, forward := params(qualified)
+
+		fmt.Fprintf(b, "// %s implements dnsimple.%s.\n", m.name, name)
+		fmt.Fprintf(b, "func (m *%s) %s%s {\n", name, m.name, strings.TrimPrefix(g.print(qualified), "func"))
+		fmt.Fprintln(b, "m.mu.Lock()")
+		fmt.Fprintf(b, "m.calls = append(m.calls, Call{Method: %q, Args: []interface{}{%s}})\n", m.name, strings.Join(args, ", "))
+		fmt.Fprintf(b, "fn := m.%sFunc\n", m.name)
+		fmt.Fprintln(b, "m.mu.Unlock()")
+		fmt.Fprintln(b)
+		fmt.Fprintln(b, "if fn == nil {")
+		fmt.Fprintf(b, "err := fmt.Errorf(\"%%w: %s.%s\", ErrNotImplemented)\n", name, m.name)
+		g.writeNotImplemented(b, qualified)
+		fmt.Fprintln(b, "}")
+		fmt.Fprintf(b, "return fn(%s)\n", strings.Join(forward, ", "))
+		fmt.Fprintln(b, "}")
+		fmt.Fprintln(b)
+	}
+}
+
+// writeNotImplemented writes the statement that returns the error of a method without function.
+func (g *generator) writeNotImplemented(b *bytes.Buffer, typ *ast.FuncType) {
+	var results []string
+	if typ.Results != nil {
+		for _, field := range typ.Results.List {
+			results = append(results, g.print(field.Type))
+		}
+	}
+
+	switch {
+	case len(results) > 0 && results[len(results)-1] == "error":
+		values := make([]string, len(results))
+		for i, result := range results {
+			values[i] = zero(result)
+		}
+		values[len(values)-1] = "err"
+		fmt.Fprintf(b, "return %s\n", strings.Join(values, ", "))
+	case len(results) == 1 && strings.HasPrefix(results[0], "iter.Seq2[") && strings.HasSuffix(results[0], ", error]"):
+		element := strings.TrimSuffix(strings.TrimPrefix(results[0], "iter.Seq2["), ", error]")
+		fmt.Fprintf(b, "return func(yield func(%s, error) bool) {\n", element)
+		fmt.Fprintf(b, "var zero %s\n", element)
+		fmt.Fprintln(b, "yield(zero, err)")
+		fmt.Fprintln(b, "}")
+	default:
+		fmt.Fprintln(b, "panic(err)")
+	}
+}
+
+// zero returns the zero value of the type.
+func zero(typ string) string {
+	switch {
+	case strings.HasPrefix(typ, "*"), strings.HasPrefix(typ, "[]"), strings.HasPrefix(typ, "map["), strings.HasPrefix(typ, "func("):
+		return "nil"
+	case typ == "string":
+		return `""`
+	case typ == "bool":
+		return "false"
+	case strings.HasPrefix(typ, "int"), strings.HasPrefix(typ, "uint"), strings.HasPrefix(typ, "float"):
+		return "0"
+	default:
+		return typ + "{}"
+	}
+}
+
+// params names the parameters of the function, and returns the arguments to record
+// and the arguments to forward to the function field.
+func params(typ *ast.FuncType) (args, forward []string) {
+	for i, field := range typ.Params.List {
+		if len(field.Names) == 0 {
+			field.Names = []*ast.Ident{ast.NewIdent("")}
+		}
+		for _, ident := range field.Names {
+			if ident.Name == "" || ident.Name == "_" {
+				ident.Name = "arg" + strconv.Itoa(i)
+			}
+			args = append(args, ident.Name)
+			if _, ok := field.Type.(*ast.Ellipsis); ok {
+				forward = append(forward, ident.Name+"...")
+			} else {
+				forward = append(forward, ident.Name)
+			}
+		}
+	}
+	return args, forward
+}
+
+// qualify returns a copy of the function type with the types of the dnsimple package
+// qualified with the package name.
+func (g *generator) qualify(typ *ast.FuncType) *ast.FuncType {
+	copied, err := parser.ParseExpr(g.print(typ))
+	if err != nil {
+		log.Fatal(err)
+	}
+	var qualifyExpr func(expr ast.Expr) ast.Expr
+	qualifyExpr = func(expr ast.Expr) ast.Expr {
+		switch expr := expr.(type) {
+		case *ast.Ident:
+			if g.types[expr.Name] {
+				return &ast.SelectorExpr{X: ast.NewIdent("dnsimple"), Sel: expr}
+			}
+		case *ast.StarExpr:
+			expr.X = qualifyExpr(expr.X)
+		case *ast.ArrayType:
+			expr.Elt = qualifyExpr(expr.Elt)
+		case *ast.MapType:
+			expr.Key, expr.Value = qualifyExpr(expr.Key), qualifyExpr(expr.Value)
+		case *ast.Ellipsis:
+			expr.Elt = qualifyExpr(expr.Elt)
+		case *ast.IndexExpr:
+			expr.Index = qualifyExpr(expr.Index)
+		case *ast.IndexListExpr:
+			for i := range expr.Indices {
+				expr.Indices[i] = qualifyExpr(expr.Indices[i])
+			}
+		case *ast.FuncType:
+			qualifyFields(expr.Params, qualifyExpr)
+			qualifyFields(expr.Results, qualifyExpr)
+		}
+		return expr
+	}
+	return qualifyExpr(copied).(*ast.FuncType)
+}
+
+func qualifyFields(fields *ast.FieldList, qualifyExpr func(ast.Expr) ast.Expr) {
+	if fields == nil {
+		return
+	}
+	for _, field := range fields.List {
+		field.Type = qualifyExpr(field.Type)
+	}
+}
+
+// collectImports adds the imports used by the signature of the method.
+func (g *generator) collectImports(m method, imports map[string]string) {
+	ast.Inspect(m.typ, func(node ast.Node) bool {
+		selector, ok := node.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		ident, ok := selector.X.(*ast.Ident)
+		if !ok {
+			return true
+		}
+		for _, spec := range m.file.Imports {
+			path, _ := strconv.Unquote(spec.Path.Value)
+			name := path[strings.LastIndex(path, "/")+1:]
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+			if name == ident.Name {
+				imports[name] = path
+			}
+		}
+		return false
+	})
+}
+
+func writeImports(b *bytes.Buffer, imports map[string]string) {
+	paths := make([]string, 0, len(imports))
+	for _, path := range imports {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+
+	// The standard library imports come first, as goimports groups them.
+	std := func(path string) bool { return !strings.Contains(strings.Split(path, "/")[0], ".") }
+	slices.SortStableFunc(paths, func(a, b string) int {
+		switch {
+		case std(a) && !std(b):
+			return -1
+		case !std(a) && std(b):
+			return 1
+		}
+		return 0
+	})
+
+	fmt.Fprintln(b, "import (")
+	for i, path := range paths {
+		if i > 0 && std(paths[i-1]) != std(path) {
+			fmt.Fprintln(b)
+		}
+		fmt.Fprintf(b, "%q\n", path)
+	}
+	fmt.Fprintln(b, ")")
+	fmt.Fprintln(b)
+}
+
+func (g *generator) print(node ast.Node) string {
+	var b bytes.Buffer
+	// The nodes are printed without positions, so that the signatures fit on one line.
+	if err := printer.Fprint(&b, token.NewFileSet(), node); err != nil {
+		log.Fatal(err)
+	}
+	return b.String()
+}
@@ -24,7 +24,7 @@ func init() {
 
 	// Prevent people from wiping out their entire production account by mistake
 	if dnsimpleBaseURL == "" {
-		dnsimpleBaseURL = "https://api.sandbox.dnsimple.com"
+		dnsimpleBaseURL = sandboxBaseURL
 	}
 
 	if len(dnsimpleToken) > 0 {
@@ -0,0 +1,176 @@
+package dnsimple
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// LogLevelTrace is the level used to log the headers and the body of the requests and responses,
+// in addition to the attributes logged for every request at slog.LevelDebug.
+//
+// Secrets such as credentials, tokens and private keys are redacted.
+const LogLevelTrace = slog.LevelDebug - 4
+
+// redacted replaces the secrets in the logs.
+const redacted = "[REDACTED]"
+
+// debugLogger is the logger used when the deprecated Client.Debug flag is set.
+var debugLogger = slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+// sensitiveHeaders are the headers whose value is redacted.
+var sensitiveHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// sensitiveFields are the JSON fields whose value is redacted.
+var sensitiveFields = map[string]bool{
+	"access_token":  true,
+	"auth_code":     true,
+	"client_secret": true,
+	"password":      true,
+	"private_key":   true,
+	"refresh_token": true,
+	"token":         true,
+}
+
+// privateKeyPattern matches PEM-encoded private keys.
+var privateKeyPattern = regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`)
+
+// logger returns the logger for the client, or nil if logging is disabled.
+func (c *Client) logger() *slog.Logger {
+	if c.Logger != nil {
+		return c.Logger
+	}
+	if c.Debug {
+		return debugLogger
+	}
+	return nil
+}
+
+// logRequest logs the outcome of an API request.
+//
+// The body of the response is logged only at LogLevelTrace,
+// in which case body contains the raw response body.
+func (c *Client) logRequest(ctx context.Context, req *http.Request, resp *http.Response, err error, duration time.Duration, body []byte) {
+	logger := c.logger()
+	if logger == nil || !logger.Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.Path),
+		slog.Duration("duration", duration),
+	}
+	if resp != nil {
+		attrs = append(attrs,
+			slog.Int("status", resp.StatusCode),
+			slog.String("request_id", resp.Header.Get("X-Request-Id")),
+			slog.Group("rate_limit",
+				slog.String("limit", resp.Header.Get("X-RateLimit-Limit")),
+				slog.String("remaining", resp.Header.Get("X-RateLimit-Remaining")),
+				slog.String("reset", resp.Header.Get("X-RateLimit-Reset")),
+			),
+		)
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+
+	level := slog.LevelDebug
+	if logger.Enabled(ctx, LogLevelTrace) {
+		level = LogLevelTrace
+		attrs = append(attrs, slog.Group("request",
+			slog.Any("headers", redactHeader(req.Header)),
+			slog.String("body", redactBody(requestBody(req))),
+		))
+		if resp != nil {
+			attrs = append(attrs, slog.Group("response",
+				slog.Any("headers", redactHeader(resp.Header)),
+				slog.String("body", redactBody(body)),
+			))
+		}
+	}
+
+	logger.LogAttrs(ctx, level, "dnsimple request", attrs...)
+}
+
+// requestBody returns a copy of the request body, without consuming it.
+func requestBody(req *http.Request) []byte {
+	if req.GetBody == nil {
+		return nil
+	}
+	rc, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer rc.Close()
+
+	body, _ := io.ReadAll(rc)
+	return body
+}
+
+// redactHeader returns a copy of the header with the credentials redacted.
+//
+// The authentication scheme is preserved, so that it is still possible
+// to tell apart Basic and Bearer authentication.
+func redactHeader(header http.Header) http.Header {
+	h := header.Clone()
+	for _, name := range sensitiveHeaders {
+		values := h[name]
+		for i, value := range values {
+			if scheme, _, ok := strings.Cut(value, " "); ok && name != "Cookie" && name != "Set-Cookie" {
+				values[i] = scheme + " " + redacted
+			} else {
+				values[i] = redacted
+			}
+		}
+	}
+	return h
+}
+
+// redactBody returns the body with the secrets redacted.
+//
+// Secrets are identified by the name of the JSON field that contains them,
+// and PEM-encoded private keys are redacted wherever they appear.
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var data interface{}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return privateKeyPattern.ReplaceAllString(string(body), redacted)
+	}
+
+	out, err := json.Marshal(redactValue(data))
+	if err != nil {
+		return ""
+	}
+	return string(out)
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if sensitiveFields[key] {
+				v[key] = redacted
+			} else {
+				v[key] = redactValue(field)
+			}
+		}
+	case []interface{}:
+		for i, entry := range v {
+			v[i] = redactValue(entry)
+		}
+	case string:
+		return privateKeyPattern.ReplaceAllString(v, redacted)
+	}
+	return value
+}
@@ -0,0 +1,79 @@
+package dnsimple
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+// Call represents an API call going through the client middleware chain.
+type Call struct {
+	// Operation is the name of the service method that originated the call,
+	// in the form Service.Method (e.g. "Zones.CreateRecord").
+	// It is empty for calls made directly with Client.Request.
+	Operation string
+
+	// AccountID is the ID of the account the call is scoped to.
+	// It is empty for calls that are not scoped to an account, such as Identity.Whoami.
+	AccountID string
+
+	// Request is the HTTP request to send.
+	// A middleware can modify or replace it before calling the next handler.
+	Request *http.Request
+
+	// Result is the value the response body is decoded into.
+	// It is populated once the next handler returns successfully.
+	Result interface{}
+}
+
+// Handler sends an API call and returns the HTTP response.
+type Handler func(ctx context.Context, call *Call) (*http.Response, error)
+
+// Middleware wraps a Handler to add behavior around API calls,
+// such as tracing, metrics, auditing or request rewriting.
+//
+// A middleware must call next to send the request, unless it wants to short-circuit the call.
+type Middleware func(next Handler) Handler
+
+// Use appends the middleware to the client middleware chain.
+//
+// The middleware are called in the order they are added:
+// the first one added is the outermost one, and sees the call first.
+func (c *Client) Use(middleware ...Middleware) {
+	c.middleware = append(c.middleware, middleware...)
+}
+
+// handler returns the Handler that sends the call through the middleware chain.
+func (c *Client) handler() Handler {
+	h := func(ctx context.Context, call *Call) (*http.Response, error) {
+		return c.request(ctx, call.Request, call.Result)
+	}
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		h = c.middleware[i](h)
+	}
+	return h
+}
+
+// unscopedResources are the top-level API resources that are not scoped to an account.
+var unscopedResources = map[string]bool{
+	"accounts": true,
+	"oauth":    true,
+	"services": true,
+	"tlds":     true,
+	"whoami":   true,
+}
+
+// accountFromPath returns the account ID of a versioned API path, if any.
+func accountFromPath(path string) string {
+	path, _, _ = strings.Cut(path, "?")
+	path, ok := strings.CutPrefix(path, "/"+apiVersion+"/")
+	if !ok {
+		return ""
+	}
+
+	segment, _, _ := strings.Cut(path, "/")
+	if unscopedResources[segment] {
+		return ""
+	}
+	return segment
+}
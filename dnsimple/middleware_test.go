@@ -0,0 +1,186 @@
+package dnsimple
+
+import (
+	"context"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Use(t *testing.T) {
+	setupMockServer()
+	defer teardownMockServer()
+
+	mux.HandleFunc("/v2/1010/zones/example.com/records/5", func(w http.ResponseWriter, r *http.Request) {
+		httpResponse := httpResponseFixture(t, "/api/getZoneRecord/success.http")
+
+		assert.Equal(t, "rewritten", r.Header.Get("X-Middleware"))
+
+		w.WriteHeader(httpResponse.StatusCode)
+		_, _ = io.Copy(w, httpResponse.Body)
+	})
+
+	var order []string
+	var seen Call
+	client.Use(
+		func(next Handler) Handler {
+			return func(ctx context.Context, call *Call) (*http.Response, error) {
+				order = append(order, "first")
+				resp, err := next(ctx, call)
+				seen = *call
+				return resp, err
+			}
+		},
+		func(next Handler) Handler {
+			return func(ctx context.Context, call *Call) (*http.Response, error) {
+				order = append(order, "second")
+				call.Request.Header.Set("X-Middleware", "rewritten")
+				return next(ctx, call)
+			}
+		},
+	)
+
+	recordResponse, err := client.Zones.GetRecord(context.Background(), "1010", "example.com", 5)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"first", "second"}, order)
+	assert.Equal(t, "Zones.GetRecord", seen.Operation)
+	assert.Equal(t, "1010", seen.AccountID)
+	assert.Equal(t, "/v2/1010/zones/example.com/records/5", seen.Request.URL.Path)
+	assert.Same(t, recordResponse, seen.Result)
+	assert.Equal(t, int64(5), seen.Result.(*ZoneRecordResponse).Data.ID)
+}
+
+func TestClient_Use_ShortCircuit(t *testing.T) {
+	setupMockServer()
+	defer teardownMockServer()
+
+	mux.HandleFunc("/v2/whoami", func(_ http.ResponseWriter, _ *http.Request) {
+		t.Fatal("the request should not be sent")
+	})
+
+	client.Use(func(_ Handler) Handler {
+		return func(_ context.Context, call *Call) (*http.Response, error) {
+			assert.Equal(t, "Identity.Whoami", call.Operation)
+			assert.Empty(t, call.AccountID)
+			return nil, context.Canceled
+		}
+	})
+
+	_, err := Whoami(context.Background(), client)
+
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestClient_Use_Request(t *testing.T) {
+	setupMockServer()
+	defer teardownMockServer()
+
+	mux.HandleFunc("/v2/1010/domains", func(w http.ResponseWriter, _ *http.Request) {
+		httpResponse := httpResponseFixture(t, "/api/listDomains/success.http")
+
+		w.WriteHeader(httpResponse.StatusCode)
+		_, _ = io.Copy(w, httpResponse.Body)
+	})
+
+	var operations []string
+	client.Use(func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) (*http.Response, error) {
+			operations = append(operations, call.Operation)
+			return next(ctx, call)
+		}
+	})
+
+	_, err := client.Request(context.Background(), "GET", "/v2/1010/domains", nil, nil, nil)
+	assert.NoError(t, err)
+	_, err = All(client.Domains.ListDomainsIter(context.Background(), "1010", nil))
+	assert.NoError(t, err)
+	_, err = client.ForAccount("1010").Domains.ListDomains(context.Background(), nil)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"", "Domains.ListDomains", "Domains.ListDomains"}, operations)
+}
+
+// TestServiceOperationNames checks that the service methods send their calls
+// with their own name as operation, in the form Service.Method.
+func TestServiceOperationNames(t *testing.T) {
+	files, err := filepath.Glob("*.go")
+	assert.NoError(t, err)
+
+	calls := 0
+	fset := token.NewFileSet()
+	for _, name := range files {
+		if strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, name, nil, 0)
+		assert.NoError(t, err)
+
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || fn.Body == nil {
+				continue
+			}
+			receiver, ok := fn.Recv.List[0].Type.(*ast.StarExpr)
+			if !ok {
+				continue
+			}
+			ident, ok := receiver.X.(*ast.Ident)
+			if !ok {
+				continue
+			}
+			service, ok := strings.CutSuffix(ident.Name, "Service")
+			if !ok {
+				continue
+			}
+
+			ast.Inspect(fn.Body, func(n ast.Node) bool {
+				call, ok := n.(*ast.CallExpr)
+				if !ok {
+					return true
+				}
+				method, ok := call.Fun.(*ast.SelectorExpr)
+				if !ok {
+					return true
+				}
+				client, ok := method.X.(*ast.SelectorExpr)
+				if !ok || client.Sel.Name != "client" {
+					return true
+				}
+				switch method.Sel.Name {
+				case "get", "post", "put", "patch", "delete":
+				default:
+					return true
+				}
+
+				calls++
+				operation, ok := call.Args[1].(*ast.BasicLit)
+				if assert.True(t, ok, "%s: the operation is not a string literal", fset.Position(call.Pos())) {
+					value, err := strconv.Unquote(operation.Value)
+					assert.NoError(t, err)
+					assert.Equal(t, service+"."+fn.Name.Name, value, fset.Position(call.Pos()).String())
+				}
+				return true
+			})
+		}
+	}
+	assert.NotZero(t, calls)
+}
+
+func TestAccountFromPath(t *testing.T) {
+	assert.Equal(t, "1010", accountFromPath("/v2/1010/zones/example.com"))
+	assert.Equal(t, "1010", accountFromPath("/v2/1010?page=2"))
+	assert.Equal(t, "", accountFromPath("/v2/whoami"))
+	assert.Equal(t, "", accountFromPath("/v2/accounts"))
+	assert.Equal(t, "", accountFromPath("/v2/tlds/com"))
+	assert.Equal(t, "", accountFromPath("/v2/oauth/access_token"))
+	assert.Equal(t, "", accountFromPath("/foo"))
+}
@@ -1,11 +1,13 @@
 package dnsimple
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 )
 
 // GrantType is a string that identifies a particular grant type in the exchange request.
@@ -43,6 +45,10 @@ type ExchangeAuthorizationRequest struct {
 	RedirectURI  string    `json:"redirect_uri,omitempty"`
 	State        string    `json:"state,omitempty"`
 	GrantType    GrantType `json:"grant_type,omitempty"`
+
+	// CodeVerifier is the PKCE code verifier, when the authorization request has a code challenge.
+	// See https://tools.ietf.org/html/rfc7636
+	CodeVerifier string `json:"code_verifier,omitempty"`
 }
 
 // ExchangeAuthorizationError represents a failed request to exchange
@@ -57,6 +63,9 @@ type ExchangeAuthorizationError struct {
 
 // Error implements the error interface.
 func (r *ExchangeAuthorizationError) Error() string {
+	if r.HTTPResponse == nil {
+		return fmt.Sprintf("%v %v", r.ErrorCode, r.ErrorDescription)
+	}
 	return fmt.Sprintf("%v %v: %v %v",
 		r.HTTPResponse.Request.Method, r.HTTPResponse.Request.URL,
 		r.ErrorCode, r.ErrorDescription)
@@ -64,15 +73,30 @@ func (r *ExchangeAuthorizationError) Error() string {
 
 // ExchangeAuthorizationForToken exchanges the short-lived authorization code for an access token
 // you can use to authenticate your API calls.
+//
+// It is equivalent to ExchangeAuthorizationForTokenContext with context.Background.
 func (s *OauthService) ExchangeAuthorizationForToken(authorization *ExchangeAuthorizationRequest) (*AccessToken, error) {
+	return s.ExchangeAuthorizationForTokenContext(context.Background(), authorization)
+}
+
+// ExchangeAuthorizationForTokenContext exchanges the short-lived authorization code for an access token
+// you can use to authenticate your API calls.
+func (s *OauthService) ExchangeAuthorizationForTokenContext(ctx context.Context, authorization *ExchangeAuthorizationRequest) (*AccessToken, error) {
+	if s.client.configErr != nil {
+		return nil, s.client.configErr
+	}
+
 	path := versioned("/oauth/access_token")
 
 	req, err := s.client.newRequest("POST", path, authorization)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
 
-	resp, err := s.client.httpClient.Do(req)
+	start := time.Now()
+	resp, err := s.client.do(ctx, req)
+	s.client.logRequest(ctx, req, resp, err, time.Since(start), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -100,6 +124,12 @@ type AuthorizationOptions struct {
 	// A randomly generated string to verify the validity of the request.
 	// Currently "state" is required by the DNSimple OAuth implementation, so you must specify it.
 	State string `url:"state,omitempty"`
+
+	// CodeChallenge is the PKCE code challenge, derived from the code verifier
+	// passed to the exchange request. See CodeChallengeS256.
+	CodeChallenge string `url:"code_challenge,omitempty"`
+	// CodeChallengeMethod is the method used to derive the code challenge, "S256" or "plain".
+	CodeChallengeMethod string `url:"code_challenge_method,omitempty"`
 }
 
 // AuthorizeURL generates the URL to authorize an user for an application via the OAuth2 flow.
@@ -0,0 +1,181 @@
+package dnsimple
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"net/url"
+	"strings"
+
+	"golang.org/x/oauth2"
+)
+
+// ErrOauthStateMismatch is returned when the state of an OAuth redirect
+// doesn't match the state of the authorization request.
+var ErrOauthStateMismatch = errors.New("dnsimple: oauth state mismatch")
+
+// GenerateState returns a cryptographically random state value
+// for an authorization request.
+func GenerateState() (string, error) {
+	return randomString(32)
+}
+
+// VerifyState reports an ErrOauthStateMismatch error if the state received
+// with the redirect doesn't match the expected one.
+// The comparison is made in constant time.
+func VerifyState(expected, actual string) error {
+	if expected == "" || subtle.ConstantTimeCompare([]byte(expected), []byte(actual)) != 1 {
+		return ErrOauthStateMismatch
+	}
+	return nil
+}
+
+// GenerateCodeVerifier returns a cryptographically random PKCE code verifier.
+// See https://tools.ietf.org/html/rfc7636#section-4.1
+func GenerateCodeVerifier() (string, error) {
+	return randomString(32)
+}
+
+// CodeChallengeS256 returns the PKCE code challenge of the verifier, with the S256 method.
+// See https://tools.ietf.org/html/rfc7636#section-4.2
+func CodeChallengeS256(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// randomString returns n random bytes, encoded with the URL-safe base64 alphabet.
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// OauthFlow drives an OAuth authorization code flow:
+// it generates the authorization URL with a random state and, optionally,
+// a PKCE code challenge, and exchanges the code of the redirect for an access token
+// after verifying the state.
+//
+// In a web application, State and CodeVerifier must be kept between the
+// authorization request and the redirect, e.g. in the user session:
+//
+//	flow, err := client.Oauth.NewFlow(clientID, clientSecret, redirectURI, true)
+//	// store flow.State and flow.CodeVerifier, then redirect to client.Oauth.FlowAuthorizeURL(flow)
+//
+//	// in the redirect handler
+//	flow := &dnsimple.OauthFlow{ClientID: clientID, ..., State: state, CodeVerifier: verifier}
+//	apiClient, token, err := client.Oauth.NewClientFromRedirect(ctx, flow, r.URL.Query())
+type OauthFlow struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURI  string
+
+	// State is the random value that binds the authorization request to the redirect.
+	State string
+
+	// CodeVerifier is the PKCE code verifier. PKCE is disabled when empty.
+	CodeVerifier string
+}
+
+// NewFlow returns an OauthFlow with a random state and, when pkce is true,
+// a random code verifier.
+func (s *OauthService) NewFlow(clientID, clientSecret, redirectURI string, pkce bool) (*OauthFlow, error) {
+	state, err := GenerateState()
+	if err != nil {
+		return nil, err
+	}
+	flow := &OauthFlow{ClientID: clientID, ClientSecret: clientSecret, RedirectURI: redirectURI, State: state}
+
+	if pkce {
+		if flow.CodeVerifier, err = GenerateCodeVerifier(); err != nil {
+			return nil, err
+		}
+	}
+	return flow, nil
+}
+
+// FlowAuthorizeURL returns the URL to redirect the user to, to authorize the application,
+// with the state and the PKCE code challenge of the flow.
+func (s *OauthService) FlowAuthorizeURL(flow *OauthFlow) string {
+	options := &AuthorizationOptions{RedirectURI: flow.RedirectURI, State: flow.State}
+	if flow.CodeVerifier != "" {
+		options.CodeChallenge = CodeChallengeS256(flow.CodeVerifier)
+		options.CodeChallengeMethod = "S256"
+	}
+	return s.AuthorizeURL(flow.ClientID, options)
+}
+
+// ExchangeRedirect verifies the state of the redirect to the application,
+// and exchanges its authorization code for an access token.
+//
+// query is the query of the redirect URL. When the user denied the authorization,
+// the error returned is an *ExchangeAuthorizationError with the error of the redirect.
+func (s *OauthService) ExchangeRedirect(ctx context.Context, flow *OauthFlow, query url.Values) (*AccessToken, error) {
+	if err := VerifyState(flow.State, query.Get("state")); err != nil {
+		return nil, err
+	}
+	if code := query.Get("error"); code != "" {
+		return nil, &ExchangeAuthorizationError{ErrorCode: code, ErrorDescription: query.Get("error_description")}
+	}
+
+	code := query.Get("code")
+	if code == "" {
+		return nil, errors.New("dnsimple: oauth redirect without authorization code")
+	}
+
+	return s.ExchangeAuthorizationForTokenContext(ctx, &ExchangeAuthorizationRequest{
+		Code:         code,
+		ClientID:     flow.ClientID,
+		ClientSecret: flow.ClientSecret,
+		RedirectURI:  flow.RedirectURI,
+		State:        flow.State,
+		GrantType:    AuthorizationCodeGrant,
+		CodeVerifier: flow.CodeVerifier,
+	})
+}
+
+// NewClientFromRedirect exchanges the redirect for an access token with ExchangeRedirect,
+// and returns a client authenticated with it, along with the token.
+//
+// The client talks to the same endpoint as this client, with the same user agent.
+// The additional options are applied after those, e.g. to set a logger or a retry policy.
+func (s *OauthService) NewClientFromRedirect(ctx context.Context, flow *OauthFlow, query url.Values, opts ...Option) (*Client, *AccessToken, error) {
+	token, err := s.ExchangeRedirect(ctx, flow, query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	opts = append([]Option{WithToken(token.Token), WithBaseURL(s.client.BaseURL), WithUserAgent(s.client.UserAgent)}, opts...)
+	return NewClient(nil, opts...), token, nil
+}
+
+// Config returns the oauth2.Config of the application,
+// with the DNSimple authorization and token endpoints.
+func (s *OauthService) Config(clientID, clientSecret, redirectURI string) *oauth2.Config {
+	authURL, _, _ := strings.Cut(s.AuthorizeURL(clientID, nil), "?")
+	return &oauth2.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		RedirectURL:  redirectURI,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:   authURL,
+			TokenURL:  s.client.BaseURL + versioned("/oauth/access_token"),
+			AuthStyle: oauth2.AuthStyleInParams,
+		},
+	}
+}
+
+// OAuth2Token returns the access token as an oauth2.Token.
+// DNSimple access tokens don't expire.
+func (t *AccessToken) OAuth2Token() *oauth2.Token {
+	return &oauth2.Token{AccessToken: t.Token, TokenType: t.Type}
+}
+
+// TokenSource returns an oauth2.TokenSource that always returns the access token.
+func (t *AccessToken) TokenSource() oauth2.TokenSource {
+	return oauth2.StaticTokenSource(t.OAuth2Token())
+}
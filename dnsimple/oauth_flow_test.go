@@ -0,0 +1,163 @@
+package dnsimple
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+func TestGenerateState(t *testing.T) {
+	state1, err := GenerateState()
+	require.NoError(t, err)
+	state2, err := GenerateState()
+	require.NoError(t, err)
+
+	assert.Len(t, state1, 43)
+	assert.NotEqual(t, state1, state2)
+}
+
+func TestVerifyState(t *testing.T) {
+	assert.NoError(t, VerifyState("state", "state"))
+	assert.ErrorIs(t, VerifyState("state", "other"), ErrOauthStateMismatch)
+	assert.ErrorIs(t, VerifyState("state", ""), ErrOauthStateMismatch)
+	assert.ErrorIs(t, VerifyState("", ""), ErrOauthStateMismatch)
+}
+
+func TestCodeChallengeS256(t *testing.T) {
+	verifier, err := GenerateCodeVerifier()
+	require.NoError(t, err)
+
+	assert.Equal(t, oauth2.S256ChallengeFromVerifier(verifier), CodeChallengeS256(verifier))
+}
+
+func TestOauthService_FlowAuthorizeURL(t *testing.T) {
+	setupMockServer()
+	defer teardownMockServer()
+	client.BaseURL = "https://api.host.test"
+
+	flow, err := client.Oauth.NewFlow("a1b2c3", "secret", "https://app.test/callback", true)
+	require.NoError(t, err)
+	assert.NotEmpty(t, flow.State)
+	assert.NotEmpty(t, flow.CodeVerifier)
+
+	authorizeURL, err := url.Parse(client.Oauth.FlowAuthorizeURL(flow))
+	require.NoError(t, err)
+	assert.Equal(t, "host.test", authorizeURL.Host)
+	assert.Equal(t, "/oauth/authorize", authorizeURL.Path)
+
+	sum := sha256.Sum256([]byte(flow.CodeVerifier))
+	assert.Equal(t, url.Values{
+		"client_id":             {"a1b2c3"},
+		"response_type":         {"code"},
+		"redirect_uri":          {"https://app.test/callback"},
+		"state":                 {flow.State},
+		"code_challenge":        {base64.RawURLEncoding.EncodeToString(sum[:])},
+		"code_challenge_method": {"S256"},
+	}, authorizeURL.Query())
+
+	flow, err = client.Oauth.NewFlow("a1b2c3", "secret", "", false)
+	require.NoError(t, err)
+	assert.Empty(t, flow.CodeVerifier)
+	assert.Equal(t, "https://host.test/oauth/authorize?client_id=a1b2c3&response_type=code&state="+flow.State, client.Oauth.FlowAuthorizeURL(flow))
+}
+
+func TestOauthService_NewClientFromRedirect(t *testing.T) {
+	setupMockServer()
+	defer teardownMockServer()
+
+	flow := &OauthFlow{ClientID: "a1b2c3", ClientSecret: "thisisasecret", State: "state", CodeVerifier: "verifier"}
+
+	mux.HandleFunc("/v2/oauth/access_token", func(w http.ResponseWriter, r *http.Request) {
+		httpResponse := httpResponseFixture(t, "/api/oauthAccessToken/success.http")
+
+		testMethod(t, r, "POST")
+		want := map[string]interface{}{"code": "1234567890", "client_id": "a1b2c3", "client_secret": "thisisasecret", "state": "state", "grant_type": "authorization_code", "code_verifier": "verifier"}
+		testRequestJSON(t, r, want)
+
+		w.WriteHeader(httpResponse.StatusCode)
+		_, _ = io.Copy(w, httpResponse.Body)
+	})
+	mux.HandleFunc("/v2/whoami", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer zKQ7OLqF5N1gylcJweA9WodA000BUNJD", r.Header.Get("Authorization"))
+		httpResponse := httpResponseFixture(t, "/api/whoami/success.http")
+
+		w.WriteHeader(httpResponse.StatusCode)
+		_, _ = io.Copy(w, httpResponse.Body)
+	})
+
+	apiClient, token, err := client.Oauth.NewClientFromRedirect(context.Background(), flow, url.Values{"code": {"1234567890"}, "state": {"state"}})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), token.AccountID)
+	assert.Equal(t, server.URL, apiClient.BaseURL)
+
+	_, err = apiClient.Identity.Whoami(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestOauthService_ExchangeRedirect_Errors(t *testing.T) {
+	setupMockServer()
+	defer teardownMockServer()
+
+	mux.HandleFunc("/v2/oauth/access_token", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected exchange request")
+	})
+
+	flow := &OauthFlow{ClientID: "a1b2c3", ClientSecret: "thisisasecret", State: "state"}
+
+	_, err := client.Oauth.ExchangeRedirect(context.Background(), flow, url.Values{"code": {"1234567890"}, "state": {"forged"}})
+	assert.ErrorIs(t, err, ErrOauthStateMismatch)
+
+	_, err = client.Oauth.ExchangeRedirect(context.Background(), flow, url.Values{"error": {"access_denied"}, "error_description": {"The user denied the request"}, "state": {"state"}})
+	var exchangeErr *ExchangeAuthorizationError
+	require.ErrorAs(t, err, &exchangeErr)
+	assert.Equal(t, "access_denied", exchangeErr.ErrorCode)
+	assert.Equal(t, "access_denied The user denied the request", err.Error())
+
+	_, err = client.Oauth.ExchangeRedirect(context.Background(), flow, url.Values{"state": {"state"}})
+	assert.EqualError(t, err, "dnsimple: oauth redirect without authorization code")
+}
+
+func TestOauthService_ExchangeAuthorizationForTokenContext_Canceled(t *testing.T) {
+	setupMockServer()
+	defer teardownMockServer()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := client.Oauth.ExchangeAuthorizationForTokenContext(ctx, &ExchangeAuthorizationRequest{Code: "1234567890", ClientID: "a1b2c3", ClientSecret: "thisisasecret"})
+
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestOauthService_Config(t *testing.T) {
+	setupMockServer()
+	defer teardownMockServer()
+	client.BaseURL = "https://api.host.test"
+
+	config := client.Oauth.Config("a1b2c3", "secret", "https://app.test/callback")
+
+	assert.Equal(t, "a1b2c3", config.ClientID)
+	assert.Equal(t, "https://app.test/callback", config.RedirectURL)
+	assert.Equal(t, "https://host.test/oauth/authorize", config.Endpoint.AuthURL)
+	assert.Equal(t, "https://api.host.test/v2/oauth/access_token", config.Endpoint.TokenURL)
+	assert.Equal(t, oauth2.AuthStyleInParams, config.Endpoint.AuthStyle)
+}
+
+func TestAccessToken_TokenSource(t *testing.T) {
+	token := &AccessToken{Token: "zKQ7OLqF5N1gylcJweA9WodA000BUNJD", Type: "Bearer", AccountID: 1}
+
+	oauth2Token, err := token.TokenSource().Token()
+
+	require.NoError(t, err)
+	assert.Equal(t, "zKQ7OLqF5N1gylcJweA9WodA000BUNJD", oauth2Token.AccessToken)
+	assert.Equal(t, "Bearer", oauth2Token.Type())
+	assert.True(t, oauth2Token.Valid())
+}
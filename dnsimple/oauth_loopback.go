@@ -0,0 +1,157 @@
+package dnsimple
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"runtime"
+	"time"
+)
+
+// LoopbackLogin configures an OAuth login for command-line tools,
+// that receives the authorization redirect on a temporary local HTTP server.
+//
+// The redirect URI registered for the OAuth application must match the address of the
+// local server, e.g. http://127.0.0.1:8085/callback.
+type LoopbackLogin struct {
+	ClientID     string
+	ClientSecret string
+
+	// Addr is the address the local server listens on.
+	// Defaults to "127.0.0.1:0", that picks a random port.
+	Addr string
+
+	// CallbackPath is the path of the redirect URI. Defaults to "/callback".
+	CallbackPath string
+
+	// PKCE enables the PKCE code challenge.
+	PKCE bool
+
+	// OpenURL sends the user to the authorization URL, e.g. with OpenBrowser.
+	// When nil, the URL is printed to Output.
+	OpenURL func(url string) error
+
+	// Output is where the instructions for the user are printed. Defaults to os.Stderr.
+	Output io.Writer
+}
+
+// LoopbackLogin runs an OAuth authorization code flow for a command-line tool,
+// and returns the access token.
+//
+// It starts a temporary local HTTP server to receive the redirect, sends the user
+// to the authorization URL, verifies the state of the redirect and exchanges the code
+// for an access token. Redirects with an invalid state are rejected, and the login
+// keeps waiting for a valid one until the context is done.
+func (s *OauthService) LoopbackLogin(ctx context.Context, login *LoopbackLogin) (*AccessToken, error) {
+	addr := login.Addr
+	if addr == "" {
+		addr = "127.0.0.1:0"
+	}
+	path := login.CallbackPath
+	if path == "" {
+		path = "/callback"
+	}
+	output := login.Output
+	if output == nil {
+		output = os.Stderr
+	}
+
+	var lc net.ListenConfig
+	listener, err := lc.Listen(ctx, "tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	redirectURI := "http://" + listener.Addr().String() + path
+	flow, err := s.NewFlow(login.ClientID, login.ClientSecret, redirectURI, login.PKCE)
+	if err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	type result struct {
+		token *AccessToken
+		err   error
+	}
+	results := make(chan result, 1)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if err := VerifyState(flow.State, query.Get("state")); err != nil {
+			http.Error(w, "Invalid state.", http.StatusBadRequest)
+			return
+		}
+
+		token, err := s.ExchangeRedirect(r.Context(), flow, query)
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, loopbackPage, "Login failed: "+html.EscapeString(err.Error()))
+		} else {
+			fmt.Fprintf(w, loopbackPage, "Login successful.")
+		}
+
+		select {
+		case results <- result{token: token, err: err}:
+		default:
+		}
+	})
+
+	server := &http.Server{Handler: mux}
+	go func() { _ = server.Serve(listener) }()
+	defer func() {
+		// Let the handler finish sending the page to the browser.
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(ctx)
+	}()
+
+	authorizeURL := s.FlowAuthorizeURL(flow)
+	// Fall back to printing the URL when it can't be opened.
+	if login.OpenURL == nil || login.OpenURL(authorizeURL) != nil {
+		fmt.Fprintf(output, "Open the following URL in your browser to log in to DNSimple:\n\n%s\n\n", authorizeURL)
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case result := <-results:
+		return result.token, result.err
+	}
+}
+
+// loopbackPage is the page shown to the user at the end of the loopback login.
+const loopbackPage = `<!DOCTYPE html>
+<html><head><title>DNSimple</title></head>
+<body><p>%s</p><p>You can close this window and return to the terminal.</p></body></html>
+`
+
+// OpenBrowser opens the URL in the default browser of the user.
+// It can be used as LoopbackLogin.OpenURL.
+func OpenBrowser(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	case "linux", "freebsd", "netbsd", "openbsd":
+		cmd = exec.Command("xdg-open", url)
+	default:
+		return errors.New("dnsimple: unable to open a browser on " + runtime.GOOS)
+	}
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	// Some launchers keep running along with the browser: the process is waited for
+	// in the background, so that it is reaped once it exits instead of being left a zombie.
+	go func() { _ = cmd.Wait() }()
+	return nil
+}
@@ -0,0 +1,131 @@
+package dnsimple
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newAuthorizationServer returns a stand-in for the DNSimple authorization server,
+// that authorizes every request and accepts the code "1234567890".
+func newAuthorizationServer(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth/authorize", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		assert.Equal(t, "a1b2c3", query.Get("client_id"))
+		assert.Equal(t, "S256", query.Get("code_challenge_method"))
+
+		redirect := query.Get("redirect_uri") + "?" + url.Values{"code": {"1234567890"}, "state": {query.Get("state")}}.Encode()
+		http.Redirect(w, r, redirect, http.StatusFound)
+	})
+	mux.HandleFunc("/v2/oauth/access_token", func(w http.ResponseWriter, r *http.Request) {
+		var request ExchangeAuthorizationRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		assert.NotEmpty(t, request.CodeVerifier)
+		assert.True(t, strings.HasPrefix(request.RedirectURI, "http://127.0.0.1:"))
+
+		if request.Code != "1234567890" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = io.WriteString(w, `{"error":"invalid_grant","error_description":"Invalid code"}`)
+			return
+		}
+		httpResponse := httpResponseFixture(t, "/api/oauthAccessToken/success.http")
+		w.WriteHeader(httpResponse.StatusCode)
+		_, _ = io.Copy(w, httpResponse.Body)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestOauthService_LoopbackLogin(t *testing.T) {
+	authServer := newAuthorizationServer(t)
+	defer authServer.Close()
+
+	c := NewClient(nil, WithBaseURL(authServer.URL))
+
+	pages := make(chan string, 1)
+	token, err := c.Oauth.LoopbackLogin(context.Background(), &LoopbackLogin{
+		ClientID:     "a1b2c3",
+		ClientSecret: "thisisasecret",
+		PKCE:         true,
+		// The browser of the user, that follows the redirect to the local server.
+		OpenURL: func(authorizeURL string) error {
+			go func() {
+				resp, err := http.Get(authorizeURL)
+				if assert.NoError(t, err) {
+					body, _ := io.ReadAll(resp.Body)
+					resp.Body.Close()
+					pages <- string(body)
+				}
+			}()
+			return nil
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, &AccessToken{Token: "zKQ7OLqF5N1gylcJweA9WodA000BUNJD", Type: "Bearer", AccountID: 1}, token)
+	select {
+	case page := <-pages:
+		assert.Contains(t, page, "Login successful.")
+	case <-time.After(time.Second):
+		t.Error("the browser didn't receive the login page")
+	}
+}
+
+func TestOauthService_LoopbackLogin_PrintsURL(t *testing.T) {
+	authServer := newAuthorizationServer(t)
+	defer authServer.Close()
+
+	c := NewClient(nil, WithBaseURL(authServer.URL))
+
+	var output bytes.Buffer
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, err := c.Oauth.LoopbackLogin(ctx, &LoopbackLogin{
+		ClientID: "a1b2c3",
+		OpenURL:  func(string) error { return errors.New("no browser") },
+		Output:   &output,
+	})
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Contains(t, output.String(), authServer.URL+"/oauth/authorize?client_id=a1b2c3")
+}
+
+func TestOauthService_LoopbackLogin_RejectsInvalidState(t *testing.T) {
+	authServer := newAuthorizationServer(t)
+	defer authServer.Close()
+
+	c := NewClient(nil, WithBaseURL(authServer.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	_, err := c.Oauth.LoopbackLogin(ctx, &LoopbackLogin{
+		ClientID: "a1b2c3",
+		Addr:     "127.0.0.1:0",
+		OpenURL: func(authorizeURL string) error {
+			u, _ := url.Parse(authorizeURL)
+			forged := u.Query().Get("redirect_uri") + "?code=1234567890&state=forged"
+			resp, err := http.Get(forged)
+			if assert.NoError(t, err) {
+				resp.Body.Close()
+				assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+			}
+			return nil
+		},
+		Output: io.Discard,
+	})
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,258 @@
+package dnsimple
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// Environment identifies a DNSimple API environment.
+type Environment string
+
+const (
+	// Production is the DNSimple production environment.
+	Production Environment = "production"
+
+	// Sandbox is the DNSimple sandbox environment, where you can test
+	// without real purchases or charges.
+	// See https://developer.dnsimple.com/sandbox/
+	Sandbox Environment = "sandbox"
+)
+
+// sandboxBaseURL to the DNSimple sandbox API.
+const sandboxBaseURL = "https://api.sandbox.dnsimple.com"
+
+// ParseEnvironment returns the Environment with the given name.
+func ParseEnvironment(name string) (Environment, error) {
+	env := Environment(strings.ToLower(strings.TrimSpace(name)))
+	if _, err := env.BaseURL(); err != nil {
+		return "", err
+	}
+	return env, nil
+}
+
+// BaseURL returns the base URL of the API for the environment.
+func (e Environment) BaseURL() (string, error) {
+	switch e {
+	case Production:
+		return defaultBaseURL, nil
+	case Sandbox:
+		return sandboxBaseURL, nil
+	}
+	return "", fmt.Errorf("dnsimple: unknown environment %q", string(e))
+}
+
+// Environment returns the environment the client is configured for,
+// or an empty Environment if BaseURL points to a custom endpoint.
+func (c *Client) Environment() Environment {
+	switch strings.TrimSuffix(c.BaseURL, "/") {
+	case defaultBaseURL:
+		return Production
+	case sandboxBaseURL:
+		return Sandbox
+	}
+	return ""
+}
+
+// clientOptions holds the settings collected from the Option values passed to NewClient.
+type clientOptions struct {
+	environment Environment
+	baseURL     string
+	token       string
+	username    string
+	password    string
+	tokenStore  TokenStore
+	timeout     time.Duration
+	apply       []func(*Client)
+	errs        []error
+}
+
+// Option configures a Client created with NewClient.
+type Option func(*clientOptions)
+
+// WithEnvironment sets the API environment the client talks to.
+// It can't be combined with WithBaseURL.
+func WithEnvironment(env Environment) Option {
+	return func(o *clientOptions) {
+		if _, err := env.BaseURL(); err != nil {
+			o.errs = append(o.errs, err)
+			return
+		}
+		o.environment = env
+	}
+}
+
+// WithSandbox configures the client for the sandbox environment.
+// It is equivalent to WithEnvironment(Sandbox).
+func WithSandbox() Option {
+	return WithEnvironment(Sandbox)
+}
+
+// WithBaseURL sets a custom base URL for the API requests, e.g. for a proxy or a test server.
+// It can't be combined with WithEnvironment.
+func WithBaseURL(baseURL string) Option {
+	return func(o *clientOptions) {
+		u, err := url.Parse(baseURL)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			o.errs = append(o.errs, fmt.Errorf("dnsimple: invalid base URL %q", baseURL))
+			return
+		}
+		o.baseURL = strings.TrimSuffix(baseURL, "/")
+	}
+}
+
+// WithToken authenticates the requests with the given OAuth access token,
+// or account/user API token. The token can't be empty.
+// It can't be combined with WithBasicAuth.
+func WithToken(token string) Option {
+	return func(o *clientOptions) {
+		if token == "" {
+			o.errs = append(o.errs, errors.New("dnsimple: WithToken requires a non-empty token"))
+			return
+		}
+		o.token = token
+	}
+}
+
+// WithBasicAuth authenticates the requests via HTTP Basic Auth. The username can't be empty.
+// It can't be combined with WithToken.
+func WithBasicAuth(username, password string) Option {
+	return func(o *clientOptions) {
+		if username == "" {
+			o.errs = append(o.errs, errors.New("dnsimple: WithBasicAuth requires a non-empty username"))
+			return
+		}
+		o.username = username
+		o.password = password
+	}
+}
+
+// WithTokenStore authenticates the requests with the current token of the store,
+// falling back to the next token when the API rejects it. See TokenStoreTransport.
+// It can't be combined with WithToken or WithBasicAuth.
+func WithTokenStore(store TokenStore) Option {
+	return func(o *clientOptions) {
+		if store == nil {
+			o.errs = append(o.errs, errors.New("dnsimple: WithTokenStore requires a token store"))
+			return
+		}
+		o.tokenStore = store
+	}
+}
+
+// WithTimeout sets the time limit for the HTTP requests.
+//
+// The http.Client passed to NewClient is not modified: the client uses a copy of it.
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *clientOptions) {
+		o.timeout = timeout
+	}
+}
+
+// WithUserAgent sets a custom user agent, see Client.SetUserAgent.
+func WithUserAgent(ua string) Option {
+	return withClient(func(c *Client) {
+		c.SetUserAgent(ua)
+	})
+}
+
+// WithLogger sets the logger for the API calls, see Client.Logger.
+func WithLogger(logger *slog.Logger) Option {
+	return withClient(func(c *Client) {
+		c.Logger = logger
+	})
+}
+
+// WithRetryPolicy sets the policy to retry failed requests, see Client.RetryPolicy.
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return withClient(func(c *Client) {
+		c.RetryPolicy = policy
+	})
+}
+
+// WithRateLimiter sets the limiter to throttle the requests, see Client.RateLimiter.
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return withClient(func(c *Client) {
+		c.RateLimiter = limiter
+	})
+}
+
+// WithPagePrefetch sets the number of pages fetched concurrently by the *Iter methods,
+// see Client.PagePrefetch.
+func WithPagePrefetch(pages int) Option {
+	return withClient(func(c *Client) {
+		c.PagePrefetch = pages
+	})
+}
+
+// WithMiddleware adds middleware to the client, see Client.Use.
+func WithMiddleware(middleware ...Middleware) Option {
+	return withClient(func(c *Client) {
+		c.Use(middleware...)
+	})
+}
+
+// withClient returns an Option that applies fn to the client once it is created.
+func withClient(fn func(*Client)) Option {
+	return func(o *clientOptions) {
+		o.apply = append(o.apply, fn)
+	}
+}
+
+// configure applies the options to the client.
+func (c *Client) configure(opts []Option) error {
+	o := &clientOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if o.environment != "" && o.baseURL != "" {
+		o.errs = append(o.errs, errors.New("dnsimple: WithEnvironment and WithBaseURL can't be combined"))
+	}
+	if (o.token != "" && o.username != "") || (o.tokenStore != nil && (o.token != "" || o.username != "")) {
+		o.errs = append(o.errs, errors.New("dnsimple: WithToken, WithBasicAuth and WithTokenStore can't be combined"))
+	}
+	if err := errors.Join(o.errs...); err != nil {
+		return err
+	}
+
+	switch {
+	case o.environment != "":
+		c.BaseURL, _ = o.environment.BaseURL()
+	case o.baseURL != "":
+		c.BaseURL = o.baseURL
+	}
+
+	if c.httpClient == nil || o.token != "" || o.username != "" || o.tokenStore != nil || o.timeout != 0 {
+		httpClient := &http.Client{}
+		if c.httpClient != nil {
+			*httpClient = *c.httpClient
+		}
+
+		switch {
+		case o.token != "":
+			ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: o.token})
+			httpClient.Transport = &oauth2.Transport{Source: ts, Base: httpClient.Transport}
+		case o.username != "":
+			httpClient.Transport = &BasicAuthTransport{Username: o.username, Password: o.password, Transport: httpClient.Transport}
+		case o.tokenStore != nil:
+			httpClient.Transport = &TokenStoreTransport{Source: NewTokenSource(o.tokenStore), Transport: httpClient.Transport}
+		}
+		if o.timeout != 0 {
+			httpClient.Timeout = o.timeout
+		}
+
+		c.httpClient = httpClient
+	}
+
+	for _, fn := range o.apply {
+		fn(c)
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+package dnsimple
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEnvironment(t *testing.T) {
+	env, err := ParseEnvironment("Sandbox")
+	require.NoError(t, err)
+	assert.Equal(t, Sandbox, env)
+
+	env, err = ParseEnvironment("production")
+	require.NoError(t, err)
+	assert.Equal(t, Production, env)
+
+	_, err = ParseEnvironment("staging")
+	assert.EqualError(t, err, `dnsimple: unknown environment "staging"`)
+}
+
+func TestNewClient_Options(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	policy := DefaultRetryPolicy()
+	limiter := NewRateLimiter()
+
+	c := NewClient(nil,
+		WithSandbox(),
+		WithUserAgent("terraform"),
+		WithLogger(logger),
+		WithRetryPolicy(policy),
+		WithRateLimiter(limiter),
+		WithPagePrefetch(4),
+		WithTimeout(5*time.Second),
+	)
+
+	require.NoError(t, c.configErr)
+	assert.Equal(t, sandboxBaseURL, c.BaseURL)
+	assert.Equal(t, Sandbox, c.Environment())
+	assert.Equal(t, "terraform", c.UserAgent)
+	assert.Same(t, logger, c.Logger)
+	assert.Same(t, policy, c.RetryPolicy)
+	assert.Same(t, limiter, c.RateLimiter)
+	assert.Equal(t, 4, c.PagePrefetch)
+	assert.Equal(t, 5*time.Second, c.httpClient.Timeout)
+}
+
+func TestNewClient_Defaults(t *testing.T) {
+	c := NewClient(nil)
+
+	require.NoError(t, c.configErr)
+	assert.NotNil(t, c.httpClient)
+	assert.Equal(t, defaultBaseURL, c.BaseURL)
+	assert.Equal(t, Production, c.Environment())
+}
+
+func TestNewClient_WithTimeoutDoesNotModifyHTTPClient(t *testing.T) {
+	httpClient := &http.Client{}
+
+	c := NewClient(httpClient, WithTimeout(time.Second))
+
+	assert.Equal(t, time.Duration(0), httpClient.Timeout)
+	assert.NotSame(t, httpClient, c.httpClient)
+	assert.Equal(t, time.Second, c.httpClient.Timeout)
+}
+
+func TestNewClient_WithToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer secret-token", r.Header.Get("Authorization"))
+		httpResponse := httpResponseFixture(t, "/api/whoami/success.http")
+
+		w.WriteHeader(httpResponse.StatusCode)
+		_, _ = io.Copy(w, httpResponse.Body)
+	}))
+	defer server.Close()
+
+	c := NewClient(nil, WithToken("secret-token"), WithBaseURL(server.URL+"/"))
+
+	assert.Equal(t, server.URL, c.BaseURL)
+	assert.Equal(t, Environment(""), c.Environment())
+	_, err := c.Identity.Whoami(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestNewClient_WithBasicAuth(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "user@example.com", username)
+		assert.Equal(t, "secret", password)
+		httpResponse := httpResponseFixture(t, "/api/whoami/success.http")
+
+		w.WriteHeader(httpResponse.StatusCode)
+		_, _ = io.Copy(w, httpResponse.Body)
+	}))
+	defer server.Close()
+
+	c := NewClient(nil, WithBasicAuth("user@example.com", "secret"), WithBaseURL(server.URL))
+
+	_, err := c.Identity.Whoami(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestNewClient_InvalidOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option
+		err  string
+	}{
+		{
+			name: "unknown environment",
+			opts: []Option{WithEnvironment("staging")},
+			err:  `dnsimple: unknown environment "staging"`,
+		},
+		{
+			name: "invalid base URL",
+			opts: []Option{WithBaseURL("api.dnsimple.com")},
+			err:  `dnsimple: invalid base URL "api.dnsimple.com"`,
+		},
+		{
+			name: "environment and base URL",
+			opts: []Option{WithSandbox(), WithBaseURL("https://proxy.example.com")},
+			err:  "dnsimple: WithEnvironment and WithBaseURL can't be combined",
+		},
+		{
+			name: "token and basic auth",
+			opts: []Option{WithToken("token"), WithBasicAuth("user", "password")},
+			err:  "dnsimple: WithToken, WithBasicAuth and WithTokenStore can't be combined",
+		},
+		{
+			name: "empty token",
+			opts: []Option{WithToken("")},
+			err:  "dnsimple: WithToken requires a non-empty token",
+		},
+		{
+			name: "empty username",
+			opts: []Option{WithBasicAuth("", "password")},
+			err:  "dnsimple: WithBasicAuth requires a non-empty username",
+		},
+		{
+			name: "nil token store",
+			opts: []Option{WithTokenStore(nil)},
+			err:  "dnsimple: WithTokenStore requires a token store",
+		},
+		{
+			name: "token store and token",
+			opts: []Option{WithTokenStore(NewMemoryTokenStore("token")), WithToken("token")},
+			err:  "dnsimple: WithToken, WithBasicAuth and WithTokenStore can't be combined",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(nil, tt.opts...)
+
+			assert.EqualError(t, c.Err(), tt.err)
+			_, err := c.Identity.Whoami(context.Background())
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestNewClient_WithMiddleware(t *testing.T) {
+	errShortCircuit := errors.New("short-circuit")
+
+	c := NewClient(nil, WithMiddleware(func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) (*http.Response, error) {
+			return nil, errShortCircuit
+		}
+	}))
+
+	_, err := c.Identity.Whoami(context.Background())
+	assert.ErrorIs(t, err, errShortCircuit)
+}
@@ -0,0 +1,162 @@
+package dnsimple
+
+import (
+	"context"
+	"iter"
+	"strconv"
+)
+
+// pageFunc fetches a single page of a paginated collection.
+type pageFunc[T any] func(ctx context.Context, page int) ([]T, *Response, error)
+
+// pageResult is the outcome of a pageFunc call.
+type pageResult[T any] struct {
+	data []T
+	resp *Response
+	err  error
+}
+
+// paginate returns an iterator over all the entries of a paginated collection,
+// starting from the given page.
+//
+// Pages are fetched lazily, as the iteration progresses. When the client
+// has PagePrefetch greater than 1, the following pages are fetched concurrently
+// once the first response reports the total number of pages.
+//
+// The iteration stops when the last page has been consumed, when the consumer
+// breaks out of the loop, or on the first error. When an error occurs, it is yielded
+// with the zero value of T as the last element of the sequence.
+func paginate[T any](ctx context.Context, c *Client, page int, fetch pageFunc[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			data, resp, err := fetch(ctx, page)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			pagination := resp.Pagination
+			if pagination == nil || pagination.CurrentPage >= pagination.TotalPages {
+				yieldAll(yield, data)
+				return
+			}
+
+			if c.PagePrefetch > 1 {
+				prefetch(ctx, c.PagePrefetch, resp, data, fetch, yield)
+				return
+			}
+
+			if !yieldAll(yield, data) {
+				return
+			}
+			page = pagination.CurrentPage + 1
+		}
+	}
+}
+
+// prefetch yields the entries of the first page, and of the remaining pages
+// fetched with up to limit concurrent requests.
+//
+// The number of requests in flight never exceeds the X-RateLimit-Remaining value
+// reported by the latest response, with a minimum of one request.
+// The pages are yielded in order.
+func prefetch[T any](ctx context.Context, limit int, first *Response, data []T, fetch pageFunc[T], yield func(T, error) bool) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	next := first.Pagination.CurrentPage + 1
+	total := first.Pagination.TotalPages
+	remaining, known := rateLimitRemaining(first)
+
+	var pending []chan pageResult[T]
+	launch := func() {
+		// Buffered, so that the request completes even if the iteration stops.
+		ch := make(chan pageResult[T], 1)
+		go func(page int) {
+			data, resp, err := fetch(ctx, page)
+			ch <- pageResult[T]{data: data, resp: resp, err: err}
+		}(next)
+		pending = append(pending, ch)
+		next++
+	}
+
+	for {
+		for next <= total && len(pending) < limit && (!known || len(pending) == 0 || len(pending) < remaining) {
+			launch()
+		}
+
+		if !yieldAll(yield, data) || len(pending) == 0 {
+			return
+		}
+
+		var result pageResult[T]
+		select {
+		case <-ctx.Done():
+			var zero T
+			yield(zero, ctx.Err())
+			return
+		case result = <-pending[0]:
+		}
+		pending = pending[1:]
+
+		if result.err != nil {
+			var zero T
+			yield(zero, result.err)
+			return
+		}
+
+		data = result.data
+		if r, ok := rateLimitRemaining(result.resp); ok {
+			remaining, known = r, true
+		}
+	}
+}
+
+// rateLimitRemaining returns the X-RateLimit-Remaining value of the response,
+// and whether the header is present.
+func rateLimitRemaining(resp *Response) (int, bool) {
+	if resp.HTTPResponse == nil {
+		return 0, false
+	}
+	value, err := strconv.Atoi(resp.HTTPResponse.Header.Get("X-RateLimit-Remaining"))
+	return value, err == nil
+}
+
+// yieldAll yields the entries, and returns false if the consumer stopped the iteration.
+func yieldAll[T any](yield func(T, error) bool, data []T) bool {
+	for _, entry := range data {
+		if !yield(entry, nil) {
+			return false
+		}
+	}
+	return true
+}
+
+// firstPage returns the page to start the iteration from.
+func firstPage(options *ListOptions) int {
+	if options.Page != nil && *options.Page > 0 {
+		return *options.Page
+	}
+	return 1
+}
+
+// All collects all the entries of the sequence returned by one of the *Iter methods,
+// such as ZonesService.ListZonesIter, and returns them.
+//
+// It stops at the first error, and returns it along with the entries collected so far.
+func All[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	var entries []T
+	for entry, err := range seq {
+		if err != nil {
+			return entries, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
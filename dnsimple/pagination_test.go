@@ -0,0 +1,199 @@
+package dnsimple
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func handlePages(t *testing.T, path string, requestedPages *[]string) {
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		*requestedPages = append(*requestedPages, page)
+
+		httpResponse := httpResponseFixture(t, fmt.Sprintf("/api/pages-%sof3.http", page))
+
+		w.WriteHeader(httpResponse.StatusCode)
+		_, _ = io.Copy(w, httpResponse.Body)
+	})
+}
+
+func TestPaginate(t *testing.T) {
+	setupMockServer()
+	defer teardownMockServer()
+
+	var pages []string
+	handlePages(t, "/v2/1010/zones", &pages)
+
+	var ids []int64
+	for zone, err := range client.Zones.ListZonesIter(context.Background(), "1010", nil) {
+		assert.NoError(t, err)
+		ids = append(ids, zone.ID)
+	}
+
+	assert.Equal(t, []int64{1, 2, 3, 4, 5}, ids)
+	assert.Equal(t, []string{"1", "2", "3"}, pages)
+}
+
+func TestPaginate_FromPage(t *testing.T) {
+	setupMockServer()
+	defer teardownMockServer()
+
+	var pages []string
+	handlePages(t, "/v2/1010/zones", &pages)
+
+	zones, err := All(client.Zones.ListZonesIter(context.Background(), "1010", &ZoneListOptions{ListOptions: ListOptions{Page: Int(2)}}))
+
+	assert.NoError(t, err)
+	assert.Len(t, zones, 3)
+	assert.Equal(t, []string{"2", "3"}, pages)
+}
+
+func TestPaginate_Break(t *testing.T) {
+	setupMockServer()
+	defer teardownMockServer()
+
+	var pages []string
+	handlePages(t, "/v2/1010/zones", &pages)
+
+	for zone := range client.Zones.ListZonesIter(context.Background(), "1010", nil) {
+		if zone.ID == 3 {
+			break
+		}
+	}
+
+	assert.Equal(t, []string{"1", "2"}, pages)
+}
+
+func TestPaginate_Error(t *testing.T) {
+	setupMockServer()
+	defer teardownMockServer()
+
+	mux.HandleFunc("/v2/1010/zones", func(w http.ResponseWriter, r *http.Request) {
+		fixture := "/api/pages-1of3.http"
+		if r.URL.Query().Get("page") != "1" {
+			fixture = "/api/notfound-zone.http"
+		}
+		httpResponse := httpResponseFixture(t, fixture)
+
+		w.WriteHeader(httpResponse.StatusCode)
+		_, _ = io.Copy(w, httpResponse.Body)
+	})
+
+	zones, err := All(client.Zones.ListZonesIter(context.Background(), "1010", nil))
+
+	var got *ErrorResponse
+	assert.ErrorAs(t, err, &got)
+	assert.Equal(t, http.StatusNotFound, got.HTTPResponse.StatusCode)
+	assert.Len(t, zones, 2)
+}
+
+func TestPaginate_ContextCanceled(t *testing.T) {
+	setupMockServer()
+	defer teardownMockServer()
+
+	var pages []string
+	handlePages(t, "/v2/1010/zones", &pages)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	zones, err := All(func(yield func(Zone, error) bool) {
+		for zone, err := range client.Zones.ListZonesIter(ctx, "1010", nil) {
+			if zone.ID == 2 {
+				cancel()
+			}
+			if !yield(zone, err) {
+				return
+			}
+		}
+	})
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Len(t, zones, 2)
+	assert.Equal(t, []string{"1"}, pages)
+}
+
+// handleGeneratedPages serves totalPages pages with one zone per page,
+// whose ID is the page number, and records the maximum number of concurrent requests.
+func handleGeneratedPages(path string, totalPages int, rateLimitRemaining string, maxInFlight *int32) {
+	var inFlight int32
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			seen := atomic.LoadInt32(maxInFlight)
+			if current <= seen || atomic.CompareAndSwapInt32(maxInFlight, seen, current) {
+				break
+			}
+		}
+
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		// let the concurrent requests overlap, and the later pages complete first
+		time.Sleep(time.Duration(totalPages-page+1) * 5 * time.Millisecond)
+
+		if rateLimitRemaining != "" {
+			w.Header().Set("X-RateLimit-Remaining", rateLimitRemaining)
+		}
+		_, _ = fmt.Fprintf(w, `{"data":[{"id":%d}],"pagination":{"current_page":%d,"per_page":1,"total_entries":%d,"total_pages":%d}}`, page, page, totalPages, totalPages)
+	})
+}
+
+func TestPaginate_Prefetch(t *testing.T) {
+	setupMockServer()
+	defer teardownMockServer()
+	client.PagePrefetch = 3
+
+	var maxInFlight int32
+	handleGeneratedPages("/v2/1010/zones", 8, "", &maxInFlight)
+
+	zones, err := All(client.Zones.ListZonesIter(context.Background(), "1010", nil))
+
+	assert.NoError(t, err)
+	var ids []int64
+	for _, zone := range zones {
+		ids = append(ids, zone.ID)
+	}
+	assert.Equal(t, []int64{1, 2, 3, 4, 5, 6, 7, 8}, ids)
+	assert.Greater(t, maxInFlight, int32(1))
+	assert.LessOrEqual(t, maxInFlight, int32(3))
+}
+
+func TestPaginate_Prefetch_RateLimitRemaining(t *testing.T) {
+	setupMockServer()
+	defer teardownMockServer()
+	client.PagePrefetch = 3
+
+	var maxInFlight int32
+	handleGeneratedPages("/v2/1010/zones", 4, "1", &maxInFlight)
+
+	zones, err := All(client.Zones.ListZonesIter(context.Background(), "1010", nil))
+
+	assert.NoError(t, err)
+	assert.Len(t, zones, 4)
+	assert.Equal(t, int32(1), maxInFlight)
+}
+
+func TestPaginate_Prefetch_Break(t *testing.T) {
+	setupMockServer()
+	defer teardownMockServer()
+	client.PagePrefetch = 2
+
+	var maxInFlight int32
+	handleGeneratedPages("/v2/1010/zones", 8, "", &maxInFlight)
+
+	var ids []int64
+	for zone := range client.Zones.ListZonesIter(context.Background(), "1010", nil) {
+		ids = append(ids, zone.ID)
+		if zone.ID == 3 {
+			break
+		}
+	}
+
+	assert.Equal(t, []int64{1, 2, 3}, ids)
+}
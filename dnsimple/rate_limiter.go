@@ -0,0 +1,172 @@
+package dnsimple
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimiter tracks the rate limit reported by the DNSimple API
+// and throttles requests before the account quota is exhausted.
+//
+// A RateLimiter is safe for concurrent use. The same RateLimiter can be
+// shared by several clients authenticated against the same account,
+// so that they draw from a single budget.
+//
+// See https://developer.dnsimple.com/v2/#rate-limiting
+type RateLimiter struct {
+	// PaceBelow is the number of remaining requests below which the limiter
+	// spreads the remaining requests evenly over the rest of the window,
+	// instead of sending them as fast as possible.
+	// When the budget is exhausted, requests block until the window resets.
+	PaceBelow int
+
+	mu        sync.Mutex
+	limit     int
+	remaining int
+	reset     time.Time
+	next      time.Time
+	waiting   int
+}
+
+// RateLimitState represents a snapshot of the RateLimiter budget.
+type RateLimitState struct {
+	// Limit is the maximum number of requests allowed in the window.
+	Limit int
+
+	// Remaining is the number of requests that can still be sent in the window,
+	// minus the requests already in flight.
+	Remaining int
+
+	// Reset is when the current window resets.
+	Reset time.Time
+
+	// Waiting is the number of requests currently held by the limiter.
+	Waiting int
+}
+
+// NewRateLimiter returns a RateLimiter that starts pacing requests
+// when less than 100 requests remain in the window.
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{PaceBelow: 100}
+}
+
+// State returns the current state of the limiter.
+//
+// The state is empty until the first response with rate limit headers is received.
+func (l *RateLimiter) State() RateLimitState {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return RateLimitState{
+		Limit:     l.limit,
+		Remaining: l.remaining,
+		Reset:     l.reset,
+		Waiting:   l.waiting,
+	}
+}
+
+// Wait blocks until a request can be sent without exceeding the budget,
+// or until the context is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		delay, granted := l.reserve(time.Now())
+		if delay > 0 {
+			l.waiting++
+		}
+		l.mu.Unlock()
+
+		if delay <= 0 {
+			return nil
+		}
+
+		err := sleep(ctx, delay)
+
+		l.mu.Lock()
+		l.waiting--
+		if err != nil && granted {
+			// Give back the slot that won't be used.
+			l.remaining++
+		}
+		l.mu.Unlock()
+
+		if err != nil {
+			return err
+		}
+		if granted {
+			return nil
+		}
+	}
+}
+
+// reserve takes a request from the budget.
+//
+// It returns how long the caller must wait before sending the request,
+// and whether the request was granted. When it is not granted,
+// the caller must wait and try again.
+func (l *RateLimiter) reserve(now time.Time) (time.Duration, bool) {
+	// Nothing is known about the budget yet.
+	if l.limit == 0 {
+		return 0, true
+	}
+
+	// The window has reset: assume the full budget until the next response says otherwise.
+	if !now.Before(l.reset) {
+		l.remaining = l.limit
+		l.next = time.Time{}
+		l.reset = now.Add(time.Hour)
+	}
+
+	if l.remaining <= 0 {
+		return l.reset.Sub(now), false
+	}
+
+	l.remaining--
+	if l.remaining >= l.PaceBelow {
+		return 0, true
+	}
+
+	// Spread the remaining requests over the rest of the window.
+	start := now
+	if l.next.After(start) {
+		start = l.next
+	}
+	l.next = start.Add(l.reset.Sub(now) / time.Duration(l.remaining+1))
+	return start.Sub(now), true
+}
+
+// Update records the rate limit headers of the response.
+func (l *RateLimiter) Update(resp *http.Response) {
+	limit, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Limit"))
+	if err != nil {
+		return
+	}
+	remaining, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return
+	}
+	resetUnix, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return
+	}
+	reset := time.Unix(resetUnix, 0)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// Responses to concurrent requests may arrive out of order:
+	// within the same window, the lowest value is the most recent one.
+	if reset.Equal(l.reset) && remaining > l.remaining {
+		return
+	}
+
+	l.limit = limit
+	l.remaining = remaining
+	if !reset.Equal(l.reset) {
+		l.next = time.Time{}
+	}
+	l.reset = reset
+}
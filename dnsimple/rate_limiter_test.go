@@ -0,0 +1,117 @@
+package dnsimple
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func rateLimitResponse(limit, remaining int, reset time.Time) *http.Response {
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("X-RateLimit-Limit", strconv.Itoa(limit))
+	resp.Header.Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+	resp.Header.Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+	return resp
+}
+
+func TestRateLimiter_Update(t *testing.T) {
+	l := NewRateLimiter()
+	assert.Equal(t, RateLimitState{}, l.State())
+
+	reset := time.Now().Add(time.Hour).Truncate(time.Second)
+	l.Update(rateLimitResponse(2400, 2000, reset))
+	assert.Equal(t, RateLimitState{Limit: 2400, Remaining: 2000, Reset: reset}, l.State())
+
+	// an older response of the same window is ignored
+	l.Update(rateLimitResponse(2400, 2100, reset))
+	assert.Equal(t, 2000, l.State().Remaining)
+
+	// a new window replaces the budget
+	l.Update(rateLimitResponse(2400, 2399, reset.Add(time.Hour)))
+	assert.Equal(t, 2399, l.State().Remaining)
+
+	// responses without headers are ignored
+	l.Update(&http.Response{Header: http.Header{}})
+	assert.Equal(t, 2399, l.State().Remaining)
+}
+
+func TestRateLimiter_Reserve(t *testing.T) {
+	now := time.Now()
+	l := &RateLimiter{PaceBelow: 2}
+
+	delay, granted := l.reserve(now)
+	assert.True(t, granted)
+	assert.Zero(t, delay)
+
+	l.Update(rateLimitResponse(10, 4, now.Add(60*time.Second)))
+	l.reset = now.Add(60 * time.Second)
+
+	// above the pacing threshold
+	for range 2 {
+		delay, granted = l.reserve(now)
+		assert.True(t, granted)
+		assert.Zero(t, delay)
+	}
+
+	// paced: the last 2 requests are spread over the remaining 60s
+	delay, granted = l.reserve(now)
+	assert.True(t, granted)
+	assert.Zero(t, delay)
+	delay, granted = l.reserve(now)
+	assert.True(t, granted)
+	assert.Equal(t, 30*time.Second, delay)
+
+	// exhausted
+	delay, granted = l.reserve(now)
+	assert.False(t, granted)
+	assert.Equal(t, 60*time.Second, delay)
+	assert.Equal(t, 0, l.State().Remaining)
+
+	// window reset
+	delay, granted = l.reserve(now.Add(61 * time.Second))
+	assert.True(t, granted)
+	assert.Zero(t, delay)
+	assert.Equal(t, 9, l.State().Remaining)
+}
+
+func TestRateLimiter_Wait_ContextDone(t *testing.T) {
+	l := NewRateLimiter()
+	l.Update(rateLimitResponse(2400, 0, time.Now().Add(time.Hour)))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := l.Wait(ctx)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 0, l.State().Waiting)
+}
+
+func TestClient_RateLimiter(t *testing.T) {
+	setupMockServer()
+	defer teardownMockServer()
+	client.RateLimiter = NewRateLimiter()
+
+	mux.HandleFunc("/v2/whoami", func(w http.ResponseWriter, _ *http.Request) {
+		httpResponse := httpResponseFixture(t, "/api/whoami/success.http")
+
+		for k, v := range httpResponse.Header {
+			w.Header()[k] = v
+		}
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10))
+		w.WriteHeader(httpResponse.StatusCode)
+		_, _ = io.Copy(w, httpResponse.Body)
+	})
+
+	_, err := client.Identity.Whoami(context.Background())
+	assert.NoError(t, err)
+
+	state := client.RateLimiter.State()
+	assert.Equal(t, 4000, state.Limit)
+	assert.Equal(t, 3991, state.Remaining)
+}
@@ -0,0 +1,292 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+)
+
+// Plan represents the changes that give a zone its desired records.
+type Plan struct {
+	// Zone is the name of the zone.
+	Zone string
+
+	// Creates are the desired records that the zone doesn't have.
+	Creates []Record
+
+	// Updates are the records of the zone to change into desired records.
+	Updates []Update
+
+	// Deletes are the records of the zone that are not desired.
+	Deletes []dnsimple.ZoneRecord
+}
+
+// Update represents a record of the zone to change into a desired record.
+type Update struct {
+	Current dnsimple.ZoneRecord
+	Desired Record
+}
+
+// NewPlan lists the records of the zone, and returns the plan to give it the desired records.
+func NewPlan(ctx context.Context, zones dnsimple.ZonesAPI, accountID string, desired *Zone) (*Plan, error) {
+	if err := desired.Validate(); err != nil {
+		return nil, err
+	}
+
+	var current []dnsimple.ZoneRecord
+	for record, err := range zones.ListRecordsIter(ctx, accountID, desired.Name, nil) {
+		if err != nil {
+			return nil, err
+		}
+		current = append(current, record)
+	}
+	return Diff(desired, current), nil
+}
+
+// Diff returns the plan to change the current records of a zone into the desired records.
+//
+// A desired record that the zone already has, the same record as defined by dnsimple.ZoneRecord.Key,
+// is updated if its TTL or regions differ. The other desired records replace the remaining records
+// of the zone with the same name and type with updates, that keep their IDs, and the desired records
+// left are created. The remaining records of the zone are deleted, except the system records.
+func Diff(desired *Zone, current []dnsimple.ZoneRecord) *Plan {
+	plan := &Plan{Zone: desired.Name}
+
+	// The records of the zone by key, in their order, to find the desired records.
+	byKey := map[string][]int{}
+	for i, record := range current {
+		key := record.Key()
+		byKey[key] = append(byKey[key], i)
+	}
+
+	var pending []Record
+	matched := make([]bool, len(current))
+	seen := map[string]bool{}
+	for _, record := range desired.Records {
+		record = record.normalized()
+		zoneRecord := record.zoneRecord(desired.Name)
+		key := zoneRecord.Key()
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		if len(byKey[key]) == 0 {
+			pending = append(pending, record)
+			continue
+		}
+		i := byKey[key][0]
+		matched[i] = true
+		if !current[i].SystemRecord && !current[i].Equal(zoneRecord) {
+			plan.Updates = append(plan.Updates, Update{Current: current[i], Desired: record})
+		}
+	}
+
+	// The records of the zone left, by record set, to be updated into the desired records left.
+	remaining := map[string][]int{}
+	for i, record := range current {
+		if !matched[i] && !record.SystemRecord {
+			key := record.SetKey()
+			remaining[key] = append(remaining[key], i)
+		}
+	}
+	for _, record := range pending {
+		key := record.zoneRecord(desired.Name).SetKey()
+		if len(remaining[key]) == 0 {
+			plan.Creates = append(plan.Creates, record)
+			continue
+		}
+		i := remaining[key][0]
+		remaining[key] = remaining[key][1:]
+		matched[i] = true
+		plan.Updates = append(plan.Updates, Update{Current: current[i], Desired: record})
+	}
+
+	for i, record := range current {
+		if !matched[i] && !record.SystemRecord {
+			plan.Deletes = append(plan.Deletes, record)
+		}
+	}
+	return plan
+}
+
+// change represents an attribute of a record that changes.
+type change struct {
+	attribute string
+	from, to  string
+}
+
+// changes returns the attributes of the current record that differ from the desired record.
+func changes(current dnsimple.ZoneRecord, desired Record) []change {
+	from := FromZoneRecord(current).normalized()
+
+	var changes []change
+	if dnsimple.NormalizeRecordContent(from.Type, from.Content) != dnsimple.NormalizeRecordContent(desired.Type, desired.Content) {
+		changes = append(changes, change{"content", from.Content, desired.Content})
+	}
+	if from.Priority != desired.Priority {
+		changes = append(changes, change{"priority", strconv.Itoa(from.Priority), strconv.Itoa(desired.Priority)})
+	}
+	if from.TTL != desired.TTL {
+		changes = append(changes, change{"ttl", strconv.Itoa(from.TTL), strconv.Itoa(desired.TTL)})
+	}
+	if !sameRegions(from.Regions, desired.Regions) {
+		changes = append(changes, change{"regions", strings.Join(from.Regions, ","), strings.Join(desired.Regions, ",")})
+	}
+	return changes
+}
+
+func sameRegions(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
+
+// Empty reports whether the plan has no changes.
+func (p *Plan) Empty() bool {
+	return len(p.Creates) == 0 && len(p.Updates) == 0 && len(p.Deletes) == 0
+}
+
+// Request returns the batch request that applies the plan.
+func (p *Plan) Request() dnsimple.BatchChangeZoneRecordsRequest {
+	var request dnsimple.BatchChangeZoneRecordsRequest
+	for _, record := range p.Creates {
+		attributes := dnsimple.ZoneRecordAttributes{
+			Type:     record.Type,
+			Name:     dnsimple.String(dnsimple.NormalizeRecordName(record.Name, p.Zone)),
+			Content:  record.Content,
+			TTL:      record.TTL,
+			Priority: record.Priority,
+		}
+		if !sameRegions(record.Regions, defaultRegions) {
+			attributes.Regions = record.Regions
+		}
+		request.Creates = append(request.Creates, attributes)
+	}
+	for _, update := range p.Updates {
+		updateRequest := dnsimple.ZoneRecordUpdateRequest{
+			ID:      update.Current.ID,
+			Content: update.Desired.Content,
+			TTL:     update.Desired.TTL,
+		}
+		if hasPriority(update.Desired.Type) {
+			// The priority is always sent, so that it can be updated to 0.
+			updateRequest.Priority = dnsimple.Int(update.Desired.Priority)
+		}
+		if !sameRegions(FromZoneRecord(update.Current).normalized().Regions, update.Desired.Regions) {
+			updateRequest.Regions = update.Desired.Regions
+		}
+		request.Updates = append(request.Updates, updateRequest)
+	}
+	for _, record := range p.Deletes {
+		request.Deletes = append(request.Deletes, dnsimple.ZoneRecordDeleteRequest{ID: record.ID})
+	}
+	return request
+}
+
+// Apply applies the plan to the zone with a single ZonesService.BatchChangeZoneRecords call,
+// so that either all the changes or none are applied. An empty plan makes no call.
+func (p *Plan) Apply(ctx context.Context, zones dnsimple.ZonesAPI, accountID string) (*dnsimple.BatchChangeZoneRecordsResponse, error) {
+	if p.Empty() {
+		return nil, nil
+	}
+	return zones.BatchChangeZoneRecords(ctx, accountID, p.Zone, p.Request())
+}
+
+// String returns the plan in a readable form. See WriteTo.
+func (p *Plan) String() string {
+	var sb strings.Builder
+	_, _ = p.WriteTo(&sb)
+	return sb.String()
+}
+
+// WriteTo writes the plan in a readable form: a summary, followed by a line per change
+// prefixed with + for the creates, ~ for the updates and - for the deletes,
+// with the changed attributes of the updates as "from -> to".
+func (p *Plan) WriteTo(w io.Writer) (int64, error) {
+	cw := &countingWriter{w: w}
+	if p.Empty() {
+		fmt.Fprintf(cw, "%s: no changes\n", p.Zone)
+		return cw.n, cw.err
+	}
+	fmt.Fprintf(cw, "%s: %d to create, %d to update, %d to delete\n\n", p.Zone, len(p.Creates), len(p.Updates), len(p.Deletes))
+
+	tw := tabwriter.NewWriter(cw, 0, 0, 2, ' ', 0)
+	for _, record := range p.Creates {
+		fmt.Fprintf(tw, "+ %s\t%d\t%s\t%s\n", displayName(record.Name), record.TTL, record.Type, withRegions(displayContent(record), displayRegions(record.Regions)))
+	}
+	for _, update := range p.Updates {
+		current := FromZoneRecord(update.Current).normalized()
+		ttl, content, regions := strconv.Itoa(current.TTL), displayContent(current), displayRegions(current.Regions)
+		for _, change := range changes(update.Current, update.Desired) {
+			switch change.attribute {
+			case "ttl":
+				ttl += " -> " + strconv.Itoa(update.Desired.TTL)
+			case "content", "priority":
+				content = displayContent(current) + " -> " + displayContent(update.Desired)
+			case "regions":
+				regions = "[" + change.from + "] -> [" + change.to + "]"
+			}
+		}
+		fmt.Fprintf(tw, "~ %s\t%s\t%s\t%s\n", displayName(current.Name), ttl, current.Type, withRegions(content, regions))
+	}
+	for _, zoneRecord := range p.Deletes {
+		record := FromZoneRecord(zoneRecord).normalized()
+		fmt.Fprintf(tw, "- %s\t%d\t%s\t%s\n", displayName(record.Name), record.TTL, record.Type, withRegions(displayContent(record), displayRegions(record.Regions)))
+	}
+	tw.Flush()
+	return cw.n, cw.err
+}
+
+func displayName(name string) string {
+	if name == "" {
+		return "@"
+	}
+	return name
+}
+
+func displayContent(record Record) string {
+	if hasPriority(record.Type) {
+		return strconv.Itoa(record.Priority) + " " + record.Content
+	}
+	return record.Content
+}
+
+// displayRegions returns the regions, or "" for the default regions.
+func displayRegions(regions []string) string {
+	if sameRegions(regions, defaultRegions) {
+		return ""
+	}
+	return "[" + strings.Join(regions, ",") + "]"
+}
+
+func withRegions(content, regions string) string {
+	if regions == "" {
+		return content
+	}
+	return content + " " + regions
+}
+
+// countingWriter counts the bytes written, and keeps the first error.
+type countingWriter struct {
+	w   io.Writer
+	n   int64
+	err error
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	if cw.err != nil {
+		return 0, cw.err
+	}
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	cw.err = err
+	return n, err
+}
@@ -0,0 +1,182 @@
+package reconcile
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple/dnsimpletest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var currentRecords = []dnsimple.ZoneRecord{
+	{ID: 1, Name: "", Type: "SOA", Content: "ns1.dnsimple.com admin.dnsimple.com 1 86400 7200 604800 300", TTL: 3600, Regions: []string{"global"}, SystemRecord: true},
+	{ID: 2, Name: "", Type: "NS", Content: "ns1.dnsimple.com", TTL: 3600, Regions: []string{"global"}, SystemRecord: true},
+	{ID: 3, Name: "", Type: "MX", Content: "mx1.example.com", Priority: 10, TTL: 3600, Regions: []string{"global"}},
+	{ID: 4, Name: "www", Type: "A", Content: "192.0.2.1", TTL: 3600, Regions: []string{"global"}},
+	{ID: 5, Name: "api", Type: "CNAME", Content: "old.example.net", TTL: 3600, Regions: []string{"global"}},
+	{ID: 6, Name: "old", Type: "TXT", Content: "remove me", TTL: 3600, Regions: []string{"global"}},
+}
+
+func TestDiff(t *testing.T) {
+	desired := &Zone{Name: "example.com", Records: []Record{
+		{Name: "", Type: "NS", Content: "ns1.dnsimple.com."},
+		{Name: "", Type: "mx", Content: "mx1.example.com.", Priority: 10},
+		{Name: "", Type: "MX", Content: "mx2.example.com", Priority: 20},
+		{Name: "WWW", Type: "A", Content: "192.0.2.1", TTL: 300},
+		{Name: "api", Type: "CNAME", Content: "new.example.net"},
+	}}
+
+	plan := Diff(desired, currentRecords)
+
+	assert.Equal(t, []Record{
+		{Name: "", Type: "MX", Content: "mx2.example.com", Priority: 20, TTL: 3600, Regions: []string{"global"}},
+	}, plan.Creates)
+	assert.Equal(t, []Update{
+		{Current: currentRecords[3], Desired: Record{Name: "WWW", Type: "A", Content: "192.0.2.1", TTL: 300, Regions: []string{"global"}}},
+		{Current: currentRecords[4], Desired: Record{Name: "api", Type: "CNAME", Content: "new.example.net", TTL: 3600, Regions: []string{"global"}}},
+	}, plan.Updates)
+	assert.Equal(t, []dnsimple.ZoneRecord{currentRecords[5]}, plan.Deletes)
+
+	assert.Equal(t, dnsimple.BatchChangeZoneRecordsRequest{
+		Creates: []dnsimple.ZoneRecordAttributes{{Name: dnsimple.String(""), Type: "MX", Content: "mx2.example.com", TTL: 3600, Priority: 20}},
+		Updates: []dnsimple.ZoneRecordUpdateRequest{
+			{ID: 4, Content: "192.0.2.1", TTL: 300},
+			{ID: 5, Content: "new.example.net", TTL: 3600},
+		},
+		Deletes: []dnsimple.ZoneRecordDeleteRequest{{ID: 6}},
+	}, plan.Request())
+
+	assert.Equal(t, `example.com: 1 to create, 2 to update, 1 to delete
+
++ @    3600         MX     20 mx2.example.com
+~ www  3600 -> 300  A      192.0.2.1
+~ api  3600         CNAME  old.example.net -> new.example.net
+- old  3600         TXT    remove me
+`, plan.String())
+}
+
+func TestDiff_NoChanges(t *testing.T) {
+	var desired []Record
+	for _, record := range currentRecords[2:] {
+		desired = append(desired, FromZoneRecord(record))
+	}
+
+	plan := Diff(&Zone{Name: "example.com", Records: desired}, currentRecords)
+
+	assert.True(t, plan.Empty())
+	assert.Equal(t, "example.com: no changes\n", plan.String())
+}
+
+func TestDiff_Regions(t *testing.T) {
+	desired := &Zone{Name: "example.com", Records: []Record{
+		{Name: "www", Type: "A", Content: "192.0.2.1", Regions: []string{"SV1", "IAD"}},
+	}}
+
+	plan := Diff(desired, currentRecords[3:4])
+
+	require.Len(t, plan.Updates, 1)
+	assert.Equal(t, []string{"SV1", "IAD"}, plan.Request().Updates[0].Regions)
+	assert.Contains(t, plan.String(), "~ www  3600  A  192.0.2.1 [global] -> [SV1,IAD]\n")
+}
+
+func TestPlan_Apply(t *testing.T) {
+	server := dnsimpletest.NewServer()
+	defer server.Close()
+	client := server.Client()
+	ctx := context.Background()
+
+	_, err := client.Domains.CreateDomain(ctx, server.AccountID, dnsimple.Domain{Name: "example.com"})
+	require.NoError(t, err)
+	for _, attributes := range []dnsimple.ZoneRecordAttributes{
+		{Name: dnsimple.String("www"), Type: "A", Content: "192.0.2.1"},
+		{Name: dnsimple.String("old"), Type: "A", Content: "192.0.2.9"},
+	} {
+		_, err := client.Zones.CreateRecord(ctx, server.AccountID, "example.com", attributes)
+		require.NoError(t, err)
+	}
+
+	desired := &Zone{Name: "example.com", Records: []Record{
+		{Name: "www", Type: "A", Content: "192.0.2.2", TTL: 300},
+		{Name: "", Type: "MX", Content: "mx.example.com", Priority: 10},
+	}}
+
+	plan, err := NewPlan(ctx, client.Zones, server.AccountID, desired)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(plan.String(), "example.com: 1 to create, 1 to update, 1 to delete\n"), plan.String())
+
+	response, err := plan.Apply(ctx, client.Zones, server.AccountID)
+	require.NoError(t, err)
+	assert.Len(t, response.Data.Creates, 1)
+
+	// The zone has the desired records: a new plan is empty.
+	plan, err = NewPlan(ctx, client.Zones, server.AccountID, desired)
+	require.NoError(t, err)
+	assert.True(t, plan.Empty(), plan.String())
+
+	response, err = plan.Apply(ctx, client.Zones, server.AccountID)
+	assert.NoError(t, err)
+	assert.Nil(t, response)
+}
+
+func TestPlan_Apply_FullyQualifiedNames(t *testing.T) {
+	server := dnsimpletest.NewServer()
+	defer server.Close()
+	client := server.Client()
+	ctx := context.Background()
+
+	_, err := client.Domains.CreateDomain(ctx, server.AccountID, dnsimple.Domain{Name: "example.com"})
+	require.NoError(t, err)
+
+	desired := &Zone{Name: "example.com", Records: []Record{
+		{Name: "www.example.com.", Type: "A", Content: "192.0.2.1"},
+		{Name: "example.com.", Type: "MX", Content: "mx.example.com", Priority: 10},
+	}}
+
+	plan, err := NewPlan(ctx, client.Zones, server.AccountID, desired)
+	require.NoError(t, err)
+	require.Len(t, plan.Creates, 2)
+	assert.Equal(t, dnsimple.String("www"), plan.Request().Creates[0].Name)
+	assert.Equal(t, dnsimple.String(""), plan.Request().Creates[1].Name)
+
+	_, err = plan.Apply(ctx, client.Zones, server.AccountID)
+	require.NoError(t, err)
+
+	// The records are created with their names relative to the zone: a new plan is empty.
+	plan, err = NewPlan(ctx, client.Zones, server.AccountID, desired)
+	require.NoError(t, err)
+	assert.True(t, plan.Empty(), plan.String())
+}
+
+func TestPlan_Apply_PriorityZero(t *testing.T) {
+	server := dnsimpletest.NewServer()
+	defer server.Close()
+	client := server.Client()
+	ctx := context.Background()
+
+	_, err := client.Domains.CreateDomain(ctx, server.AccountID, dnsimple.Domain{Name: "example.com"})
+	require.NoError(t, err)
+	_, err = client.Zones.CreateRecord(ctx, server.AccountID, "example.com", dnsimple.ZoneRecordAttributes{
+		Name: dnsimple.String(""), Type: "MX", Content: "mx.example.com", Priority: 10,
+	})
+	require.NoError(t, err)
+
+	desired := &Zone{Name: "example.com", Records: []Record{
+		{Name: "", Type: "MX", Content: "mx.example.com", Priority: 0},
+	}}
+
+	plan, err := NewPlan(ctx, client.Zones, server.AccountID, desired)
+	require.NoError(t, err)
+	require.Len(t, plan.Updates, 1)
+	assert.Equal(t, dnsimple.Int(0), plan.Request().Updates[0].Priority)
+
+	_, err = plan.Apply(ctx, client.Zones, server.AccountID)
+	require.NoError(t, err)
+
+	// The priority is updated to 0: a new plan is empty.
+	plan, err = NewPlan(ctx, client.Zones, server.AccountID, desired)
+	require.NoError(t, err)
+	assert.True(t, plan.Empty(), plan.String())
+}
@@ -0,0 +1,184 @@
+// Package reconcile manages the records of a zone declaratively.
+//
+// The desired records of a zone are described in Go or in YAML, and compared to the records
+// of the zone to plan the records to create, update and delete. The plan can be printed for review,
+// and then applied atomically with ZonesService.BatchChangeZoneRecords:
+//
+//	desired, err := reconcile.LoadFile("example.com.yaml")
+//	if err != nil {
+//		return err
+//	}
+//	plan, err := reconcile.NewPlan(ctx, client.Zones, accountID, desired)
+//	if err != nil {
+//		return err
+//	}
+//	fmt.Print(plan)
+//	if _, err := plan.Apply(ctx, client.Zones, accountID); err != nil {
+//		return err
+//	}
+//
+// The system records of the zone, such as the SOA record and the NS records of the apex,
+// are managed by DNSimple and left out of the plans.
+package reconcile
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultTTL is the TTL of the records without TTL, as in the API.
+const DefaultTTL = 3600
+
+// defaultRegions are the regions of the records without regions, as in the API.
+var defaultRegions = []string{"global"}
+
+// Zone represents the desired records of a zone.
+type Zone struct {
+	// Name of the zone, e.g. example.com.
+	Name string `yaml:"zone"`
+
+	// Records are the desired records of the zone, other than its system records.
+	// The zone has these records, and only these, once the plan is applied.
+	Records []Record `yaml:"records"`
+}
+
+// Record represents a desired record of a zone.
+type Record struct {
+	// Name relative to the zone, "" or "@" for the apex,
+	// or fully qualified with a trailing dot, e.g. www.example.com.
+	Name string `yaml:"name"`
+	Type string `yaml:"type"`
+
+	// Content as in dnsimple.ZoneRecord.
+	Content string `yaml:"content"`
+
+	// TTL in seconds, DefaultTTL when 0.
+	TTL int `yaml:"ttl,omitempty"`
+
+	// Priority of the MX and SRV records.
+	Priority int `yaml:"priority,omitempty"`
+
+	// Regions where the record is served, all the regions when empty.
+	Regions []string `yaml:"regions,omitempty"`
+}
+
+// Load decodes the desired records of a zone from YAML:
+//
+//	zone: example.com
+//	records:
+//	  - name: ""
+//	    type: MX
+//	    content: mx.example.com
+//	    priority: 10
+//	  - name: www
+//	    type: CNAME
+//	    content: example.com
+//	    ttl: 300
+//
+// The unknown fields are errors, to catch the typos.
+func Load(r io.Reader) (*Zone, error) {
+	decoder := yaml.NewDecoder(r)
+	decoder.KnownFields(true)
+
+	zone := &Zone{}
+	if err := decoder.Decode(zone); err != nil {
+		return nil, fmt.Errorf("reconcile: %w", err)
+	}
+	if err := zone.Validate(); err != nil {
+		return nil, err
+	}
+	return zone, nil
+}
+
+// LoadFile decodes the desired records of a zone from a YAML file. See Load.
+func LoadFile(name string) (*Zone, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Load(f)
+}
+
+// Validate checks that the zone has a name, and that its records have a type and a content,
+// are not SOA records, that are managed by DNSimple, and that their fully qualified names are in the zone.
+func (z *Zone) Validate() error {
+	if z.Name == "" {
+		return errors.New("reconcile: missing zone name")
+	}
+	for i, record := range z.Records {
+		switch {
+		case record.Type == "":
+			return fmt.Errorf("reconcile: record %d: missing type", i)
+		case record.Content == "":
+			return fmt.Errorf("reconcile: record %d: missing content", i)
+		case strings.EqualFold(record.Type, "SOA"):
+			return fmt.Errorf("reconcile: record %d: the SOA record is managed by DNSimple", i)
+		case !inZone(record.Name, z.Name):
+			return fmt.Errorf("reconcile: record %d: name %s is not in zone %s", i, record.Name, z.Name)
+		}
+	}
+	return nil
+}
+
+// normalized returns the record with the defaults of the API for the attributes that are not set.
+func (r Record) normalized() Record {
+	r.Type = strings.ToUpper(r.Type)
+	if r.TTL == 0 {
+		r.TTL = DefaultTTL
+	}
+	if !hasPriority(r.Type) {
+		r.Priority = 0
+	}
+	if len(r.Regions) == 0 {
+		r.Regions = defaultRegions
+	}
+	return r
+}
+
+// zoneRecord returns the record of the zone with the attributes of the desired record.
+func (r Record) zoneRecord(zone string) dnsimple.ZoneRecord {
+	return dnsimple.ZoneRecord{
+		ZoneID:   zone,
+		Name:     r.Name,
+		Type:     r.Type,
+		Content:  r.Content,
+		TTL:      r.TTL,
+		Priority: r.Priority,
+		Regions:  r.Regions,
+	}
+}
+
+// FromZoneRecord returns the desired record with the attributes of a record of a zone.
+func FromZoneRecord(record dnsimple.ZoneRecord) Record {
+	return Record{
+		Name:     record.Name,
+		Type:     record.Type,
+		Content:  record.Content,
+		TTL:      record.TTL,
+		Priority: record.Priority,
+		Regions:  slices.Clone(record.Regions),
+	}
+}
+
+// inZone reports whether a record name is in the zone: the relative names are,
+// and the fully qualified names if they are the zone or end with it.
+func inZone(name, zone string) bool {
+	if !strings.HasSuffix(name, ".") {
+		return true
+	}
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	zone = strings.ToLower(strings.TrimSuffix(zone, "."))
+	return name == zone || strings.HasSuffix(name, "."+zone)
+}
+
+func hasPriority(recordType string) bool {
+	return recordType == "MX" || recordType == "SRV"
+}
@@ -0,0 +1,57 @@
+package reconcile
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	zone, err := Load(strings.NewReader(`
+zone: example.com
+records:
+  - name: ""
+    type: MX
+    content: mx.example.com
+    priority: 10
+  - name: www
+    type: CNAME
+    content: example.com
+    ttl: 300
+    regions: [SV1, IAD]
+`))
+
+	require.NoError(t, err)
+	assert.Equal(t, &Zone{
+		Name: "example.com",
+		Records: []Record{
+			{Name: "", Type: "MX", Content: "mx.example.com", Priority: 10},
+			{Name: "www", Type: "CNAME", Content: "example.com", TTL: 300, Regions: []string{"SV1", "IAD"}},
+		},
+	}, zone)
+}
+
+func TestLoad_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		yaml string
+		err  string
+	}{
+		{"unknown field", "zone: example.com\nrecords:\n  - name: www\n    typ: A\n", "field typ not found"},
+		{"no zone", "records: []\n", "reconcile: missing zone name"},
+		{"no type", "zone: example.com\nrecords:\n  - name: www\n    content: 192.0.2.1\n", "reconcile: record 0: missing type"},
+		{"no content", "zone: example.com\nrecords:\n  - name: www\n    type: A\n", "reconcile: record 0: missing content"},
+		{"soa", "zone: example.com\nrecords:\n  - type: SOA\n    content: ns1.dnsimple.com admin.dnsimple.com 1 2 3 4 5\n", "reconcile: record 0: the SOA record is managed by DNSimple"},
+		{"out of zone", "zone: example.com\nrecords:\n  - name: www.example.org.\n    type: A\n    content: 192.0.2.1\n", "reconcile: record 0: name www.example.org. is not in zone example.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Load(strings.NewReader(tt.yaml))
+
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
@@ -33,7 +33,7 @@ func (s *RegistrarService) CheckDomain(ctx context.Context, accountID string, do
 	path := versioned(fmt.Sprintf("/%v/registrar/domains/%v/check", accountID, domainName))
 	checkResponse := &DomainCheckResponse{}
 
-	resp, err := s.client.get(ctx, path, checkResponse)
+	resp, err := s.client.get(ctx, "Registrar.CheckDomain", path, checkResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -66,7 +66,7 @@ func (s *RegistrarService) GetDomainPrices(ctx context.Context, accountID string
 	path := versioned(fmt.Sprintf("/%v/registrar/domains/%v/prices", accountID, domainName))
 	pricesResponse := &DomainPriceResponse{}
 
-	resp, err := s.client.get(ctx, path, pricesResponse)
+	resp, err := s.client.get(ctx, "Registrar.GetDomainPrices", path, pricesResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -103,7 +103,7 @@ func (s *RegistrarService) GetDomainRegistration(ctx context.Context, accountID
 	path := versioned(fmt.Sprintf("/%v/registrar/domains/%v/registrations/%v", accountID, domainName, domainRegistrationID))
 	res := &DomainRegistrationResponse{}
 
-	resp, err := s.client.get(ctx, path, res)
+	resp, err := s.client.get(ctx, "Registrar.GetDomainRegistration", path, res)
 	if err != nil {
 		return nil, err
 	}
@@ -140,7 +140,7 @@ func (s *RegistrarService) RegisterDomain(ctx context.Context, accountID string,
 
 	// TODO: validate mandatory attributes RegistrantID
 
-	resp, err := s.client.post(ctx, path, input, registrationResponse)
+	resp, err := s.client.post(ctx, "Registrar.RegisterDomain", path, input, registrationResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -201,7 +201,7 @@ func (s *RegistrarService) TransferDomain(ctx context.Context, accountID string,
 
 	// TODO: validate mandatory attributes RegistrantID
 
-	resp, err := s.client.post(ctx, path, input, transferResponse)
+	resp, err := s.client.post(ctx, "Registrar.TransferDomain", path, input, transferResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -217,7 +217,7 @@ func (s *RegistrarService) GetDomainTransfer(ctx context.Context, accountID stri
 	path := versioned(fmt.Sprintf("/%v/registrar/domains/%v/transfers/%v", accountID, domainName, domainTransferID))
 	transferResponse := &DomainTransferResponse{}
 
-	resp, err := s.client.get(ctx, path, transferResponse)
+	resp, err := s.client.get(ctx, "Registrar.GetDomainTransfer", path, transferResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -233,7 +233,7 @@ func (s *RegistrarService) CancelDomainTransfer(ctx context.Context, accountID s
 	path := versioned(fmt.Sprintf("/%v/registrar/domains/%v/transfers/%v", accountID, domainName, domainTransferID))
 	transferResponse := &DomainTransferResponse{}
 
-	resp, err := s.client.delete(ctx, path, nil, transferResponse)
+	resp, err := s.client.delete(ctx, "Registrar.CancelDomainTransfer", path, nil, transferResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -255,7 +255,7 @@ func (s *RegistrarService) TransferDomainOut(ctx context.Context, accountID stri
 	path := versioned(fmt.Sprintf("/%v/registrar/domains/%v/authorize_transfer_out", accountID, domainName))
 	transferResponse := &DomainTransferOutResponse{}
 
-	resp, err := s.client.post(ctx, path, nil, nil)
+	resp, err := s.client.post(ctx, "Registrar.TransferDomainOut", path, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -303,7 +303,7 @@ func (s *RegistrarService) GetDomainRenewal(ctx context.Context, accountID strin
 	path := versioned(fmt.Sprintf("/%v/registrar/domains/%v/renewals/%v", accountID, domainName, domainRenewalID))
 	res := &DomainRenewalResponse{}
 
-	resp, err := s.client.get(ctx, path, res)
+	resp, err := s.client.get(ctx, "Registrar.GetDomainRenewal", path, res)
 	if err != nil {
 		return nil, err
 	}
@@ -328,7 +328,7 @@ func (s *RegistrarService) RenewDomain(ctx context.Context, accountID string, do
 	path := versioned(fmt.Sprintf("/%v/registrar/domains/%v/renewals", accountID, domainName))
 	renewalResponse := &DomainRenewalResponse{}
 
-	resp, err := s.client.post(ctx, path, input, renewalResponse)
+	resp, err := s.client.post(ctx, "Registrar.RenewDomain", path, input, renewalResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -344,7 +344,7 @@ func (s *RegistrarService) RestoreDomain(ctx context.Context, accountID string,
 	path := versioned(fmt.Sprintf("/%v/registrar/domains/%v/restores", accountID, domainName))
 	renewalResponse := &DomainRenewalResponse{}
 
-	resp, err := s.client.post(ctx, path, input, renewalResponse)
+	resp, err := s.client.post(ctx, "Registrar.RestoreDomain", path, input, renewalResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -361,7 +361,7 @@ func (s *RegistrarService) GetDomainRestore(ctx context.Context, accountID strin
 	path := versioned(fmt.Sprintf("/%v/registrar/domains/%v/restores/%v", accountID, domainName, domainRestoreID))
 	res := &DomainRestoreResponse{}
 
-	resp, err := s.client.get(ctx, path, res)
+	resp, err := s.client.get(ctx, "Registrar.GetDomainRestore", path, res)
 	if err != nil {
 		return nil, err
 	}
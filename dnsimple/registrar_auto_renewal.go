@@ -12,7 +12,7 @@ func (s *RegistrarService) EnableDomainAutoRenewal(ctx context.Context, accountI
 	path := versioned(fmt.Sprintf("/%v/registrar/domains/%v/auto_renewal", accountID, domainName))
 	domainResponse := &DomainResponse{}
 
-	resp, err := s.client.put(ctx, path, nil, nil)
+	resp, err := s.client.put(ctx, "Registrar.EnableDomainAutoRenewal", path, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -28,7 +28,7 @@ func (s *RegistrarService) DisableDomainAutoRenewal(ctx context.Context, account
 	path := versioned(fmt.Sprintf("/%v/registrar/domains/%v/auto_renewal", accountID, domainName))
 	domainResponse := &DomainResponse{}
 
-	resp, err := s.client.delete(ctx, path, nil, nil)
+	resp, err := s.client.delete(ctx, "Registrar.DisableDomainAutoRenewal", path, nil, nil)
 	if err != nil {
 		return nil, err
 	}
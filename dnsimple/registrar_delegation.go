@@ -27,7 +27,7 @@ func (s *RegistrarService) GetDomainDelegation(ctx context.Context, accountID st
 	path := versioned(fmt.Sprintf("/%v/registrar/domains/%v/delegation", accountID, domainName))
 	delegationResponse := &DelegationResponse{}
 
-	resp, err := s.client.get(ctx, path, delegationResponse)
+	resp, err := s.client.get(ctx, "Registrar.GetDomainDelegation", path, delegationResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -43,7 +43,7 @@ func (s *RegistrarService) ChangeDomainDelegation(ctx context.Context, accountID
 	path := versioned(fmt.Sprintf("/%v/registrar/domains/%v/delegation", accountID, domainName))
 	delegationResponse := &DelegationResponse{}
 
-	resp, err := s.client.put(ctx, path, newDelegation, delegationResponse)
+	resp, err := s.client.put(ctx, "Registrar.ChangeDomainDelegation", path, newDelegation, delegationResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -59,7 +59,7 @@ func (s *RegistrarService) ChangeDomainDelegationToVanity(ctx context.Context, a
 	path := versioned(fmt.Sprintf("/%v/registrar/domains/%v/delegation/vanity", accountID, domainName))
 	delegationResponse := &VanityDelegationResponse{}
 
-	resp, err := s.client.put(ctx, path, newDelegation, delegationResponse)
+	resp, err := s.client.put(ctx, "Registrar.ChangeDomainDelegationToVanity", path, newDelegation, delegationResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -75,7 +75,7 @@ func (s *RegistrarService) ChangeDomainDelegationFromVanity(ctx context.Context,
 	path := versioned(fmt.Sprintf("/%v/registrar/domains/%v/delegation/vanity", accountID, domainName))
 	delegationResponse := &VanityDelegationResponse{}
 
-	resp, err := s.client.delete(ctx, path, nil, nil)
+	resp, err := s.client.delete(ctx, "Registrar.ChangeDomainDelegationFromVanity", path, nil, nil)
 	if err != nil {
 		return nil, err
 	}
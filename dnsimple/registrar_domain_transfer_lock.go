@@ -21,7 +21,7 @@ func (s *RegistrarService) GetDomainTransferLock(ctx context.Context, accountID
 	path := versioned(fmt.Sprintf("%v/registrar/domains/%v/transfer_lock", accountID, domainIdentifier))
 	transferLockResponse := &DomainTransferLockResponse{}
 
-	resp, err := s.client.get(ctx, path, transferLockResponse)
+	resp, err := s.client.get(ctx, "Registrar.GetDomainTransferLock", path, transferLockResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -37,7 +37,7 @@ func (s *RegistrarService) EnableDomainTransferLock(ctx context.Context, account
 	path := versioned(fmt.Sprintf("%v/registrar/domains/%v/transfer_lock", accountID, domainIdentifier))
 	transferLockResponse := &DomainTransferLockResponse{}
 
-	resp, err := s.client.post(ctx, path, nil, transferLockResponse)
+	resp, err := s.client.post(ctx, "Registrar.EnableDomainTransferLock", path, nil, transferLockResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -53,7 +53,7 @@ func (s *RegistrarService) DisableDomainTransferLock(ctx context.Context, accoun
 	path := versioned(fmt.Sprintf("%v/registrar/domains/%v/transfer_lock", accountID, domainIdentifier))
 	transferLockResponse := &DomainTransferLockResponse{}
 
-	resp, err := s.client.delete(ctx, path, nil, transferLockResponse)
+	resp, err := s.client.delete(ctx, "Registrar.DisableDomainTransferLock", path, nil, transferLockResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -3,6 +3,7 @@ package dnsimple
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 type CreateRegistrantChangeInput struct {
@@ -92,7 +93,7 @@ func (s *RegistrarService) ListRegistrantChange(ctx context.Context, accountID s
 		return nil, err
 	}
 
-	resp, err := s.client.get(ctx, path, changeResponse)
+	resp, err := s.client.get(ctx, "Registrar.ListRegistrantChange", path, changeResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -101,6 +102,28 @@ func (s *RegistrarService) ListRegistrantChange(ctx context.Context, accountID s
 	return changeResponse, nil
 }
 
+// ListRegistrantChangeIter returns an iterator over the registrant changes in the account,
+// that fetches the pages lazily as the iteration progresses.
+//
+// See ListRegistrantChange for the available options, and All to collect the results.
+func (s *RegistrarService) ListRegistrantChangeIter(ctx context.Context, accountID string, options *RegistrantChangeListOptions) iter.Seq2[RegistrantChange, error] {
+	opts := RegistrantChangeListOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	return paginate(ctx, s.client, firstPage(&opts.ListOptions), func(ctx context.Context, page int) ([]RegistrantChange, *Response, error) {
+		opts := opts
+		opts.Page = &page
+
+		resp, err := s.ListRegistrantChange(ctx, accountID, &opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Data, &resp.Response, nil
+	})
+}
+
 // CreateRegistrantChange starts a registrant change.
 //
 // See https://developer.dnsimple.com/v2/registrar/#createRegistrantChange
@@ -108,7 +131,7 @@ func (s *RegistrarService) CreateRegistrantChange(ctx context.Context, accountID
 	path := versioned(fmt.Sprintf("/%v/registrar/registrant_changes", accountID))
 	changeResponse := &RegistrantChangeResponse{}
 
-	resp, err := s.client.post(ctx, path, input, changeResponse)
+	resp, err := s.client.post(ctx, "Registrar.CreateRegistrantChange", path, input, changeResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -124,7 +147,7 @@ func (s *RegistrarService) CheckRegistrantChange(ctx context.Context, accountID
 	path := versioned(fmt.Sprintf("/%v/registrar/registrant_changes/check", accountID))
 	checkResponse := &RegistrantChangeCheckResponse{}
 
-	resp, err := s.client.post(ctx, path, input, checkResponse)
+	resp, err := s.client.post(ctx, "Registrar.CheckRegistrantChange", path, input, checkResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -140,7 +163,7 @@ func (s *RegistrarService) GetRegistrantChange(ctx context.Context, accountID st
 	path := versioned(fmt.Sprintf("/%v/registrar/registrant_changes/%v", accountID, registrantChange))
 	checkResponse := &RegistrantChangeResponse{}
 
-	resp, err := s.client.get(ctx, path, checkResponse)
+	resp, err := s.client.get(ctx, "Registrar.GetRegistrantChange", path, checkResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -156,7 +179,7 @@ func (s *RegistrarService) DeleteRegistrantChange(ctx context.Context, accountID
 	path := versioned(fmt.Sprintf("/%v/registrar/registrant_changes/%v", accountID, registrantChange))
 	deleteResponse := &RegistrantChangeDeleteResponse{}
 
-	resp, err := s.client.delete(ctx, path, nil, deleteResponse)
+	resp, err := s.client.delete(ctx, "Registrar.DeleteRegistrantChange", path, nil, deleteResponse)
 	if err != nil {
 		return nil, err
 	}
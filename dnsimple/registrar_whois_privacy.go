@@ -28,7 +28,7 @@ func (s *RegistrarService) EnableWhoisPrivacy(ctx context.Context, accountID str
 	path := versioned(fmt.Sprintf("/%v/registrar/domains/%v/whois_privacy", accountID, domainName))
 	privacyResponse := &WhoisPrivacyResponse{}
 
-	resp, err := s.client.put(ctx, path, nil, privacyResponse)
+	resp, err := s.client.put(ctx, "Registrar.EnableWhoisPrivacy", path, nil, privacyResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -44,7 +44,7 @@ func (s *RegistrarService) DisableWhoisPrivacy(ctx context.Context, accountID st
 	path := versioned(fmt.Sprintf("/%v/registrar/domains/%v/whois_privacy", accountID, domainName))
 	privacyResponse := &WhoisPrivacyResponse{}
 
-	resp, err := s.client.delete(ctx, path, nil, privacyResponse)
+	resp, err := s.client.delete(ctx, "Registrar.DisableWhoisPrivacy", path, nil, privacyResponse)
 	if err != nil {
 		return nil, err
 	}
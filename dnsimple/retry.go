@@ -0,0 +1,196 @@
+package dnsimple
+
+import (
+	"context"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how the client retries requests that failed
+// with a 429 Too Many Requests or a 5xx server error.
+//
+// Retries are disabled when Client.RetryPolicy is nil.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts for a single request,
+	// including the first one. Values lower than 1 are treated as 1.
+	MaxAttempts int
+
+	// MaxWait caps the total time spent waiting between attempts.
+	// When the next delay would exceed the remaining budget, the client
+	// stops retrying and returns the last error.
+	// A zero value means no cap.
+	MaxWait time.Duration
+
+	// MinBackoff is the base delay of the exponential backoff.
+	MinBackoff time.Duration
+
+	// MaxBackoff is the maximum delay of the exponential backoff.
+	// It doesn't apply to delays requested by the server via
+	// the Retry-After or X-RateLimit-Reset headers.
+	MaxBackoff time.Duration
+
+	// RetryNonIdempotent enables retries for non-idempotent methods (POST and PATCH).
+	// By default only GET, HEAD, OPTIONS, PUT and DELETE requests are retried.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns a RetryPolicy with sensible defaults:
+// up to 3 attempts, an exponential backoff between 500ms and 30s,
+// and at most 1 minute spent waiting.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		MaxWait:     time.Minute,
+		MinBackoff:  500 * time.Millisecond,
+		MaxBackoff:  30 * time.Second,
+	}
+}
+
+// retryable returns true if the request can be attempted again
+// after receiving the given response.
+func (p *RetryPolicy) retryable(req *http.Request, resp *http.Response, attempt int) bool {
+	if attempt >= p.MaxAttempts {
+		return false
+	}
+
+	code := resp.StatusCode
+	if code != http.StatusTooManyRequests && (code < 500 || code > 599) {
+		return false
+	}
+
+	if !p.RetryNonIdempotent && !isIdempotent(req.Method) {
+		return false
+	}
+
+	// The body has already been consumed and can't be sent again.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	return true
+}
+
+// delay returns how long to wait before the next attempt.
+//
+// The server directives take precedence: the Retry-After header first,
+// then X-RateLimit-Reset for rate limited requests.
+// Otherwise it falls back to an exponential backoff with jitter.
+func (p *RetryPolicy) delay(resp *http.Response, attempt int) time.Duration {
+	if d, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+		return d
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+			return max(time.Until(time.Unix(reset, 0)), 0)
+		}
+	}
+
+	return p.backoff(attempt)
+}
+
+// backoff returns the exponential backoff for the given attempt,
+// with half of it randomized to spread concurrent retries.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.MinBackoff
+	for i := 1; i < attempt && (p.MaxBackoff <= 0 || d < p.MaxBackoff); i++ {
+		d *= 2
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+
+	half := d / 2
+	return half + rand.N(d-half+1)
+}
+
+// retryAfter parses the value of a Retry-After header,
+// expressed either in seconds or as an HTTP date.
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0), true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// do sends the HTTP request, retrying it according to the client RetryPolicy.
+// When the client has a RateLimiter, each attempt waits for its turn.
+func (c *Client) do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	policy := c.RetryPolicy
+
+	var waited time.Duration
+	for attempt := 1; ; attempt++ {
+		if c.RateLimiter != nil {
+			if err := c.RateLimiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := c.httpClient.Do(req)
+		if err == nil && c.RateLimiter != nil {
+			c.RateLimiter.Update(resp)
+		}
+		if err != nil || policy == nil || !policy.retryable(req, resp, attempt) {
+			return resp, err
+		}
+
+		delay := policy.delay(resp, attempt)
+		if policy.MaxWait > 0 && waited+delay > policy.MaxWait {
+			return resp, nil
+		}
+
+		// Drain the body so that the connection can be reused.
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		if err := sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+		waited += delay
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+	}
+}
+
+// sleep waits for the given duration, or until the context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,150 @@
+package dnsimple
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond}
+}
+
+func TestClient_Retry_ServerError(t *testing.T) {
+	setupMockServer()
+	defer teardownMockServer()
+	client.RetryPolicy = testRetryPolicy()
+
+	attempts := 0
+	mux.HandleFunc("/v2/whoami", func(w http.ResponseWriter, _ *http.Request) {
+		attempts++
+		fixture := "/api/badgateway.http"
+		if attempts == 3 {
+			fixture = "/api/whoami/success.http"
+		}
+		httpResponse := httpResponseFixture(t, fixture)
+
+		w.WriteHeader(httpResponse.StatusCode)
+		_, _ = io.Copy(w, httpResponse.Body)
+	})
+
+	whoamiResponse, err := client.Identity.Whoami(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+	assert.Equal(t, int64(1), whoamiResponse.Data.Account.ID)
+}
+
+func TestClient_Retry_MaxAttempts(t *testing.T) {
+	setupMockServer()
+	defer teardownMockServer()
+	client.RetryPolicy = testRetryPolicy()
+
+	attempts := 0
+	mux.HandleFunc("/v2/whoami", func(w http.ResponseWriter, _ *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, err := client.Identity.Whoami(context.Background())
+
+	assert.Error(t, err)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestClient_Retry_NonIdempotent(t *testing.T) {
+	setupMockServer()
+	defer teardownMockServer()
+	client.RetryPolicy = testRetryPolicy()
+
+	attempts := 0
+	mux.HandleFunc("/v2/1010/domains", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		want := map[string]interface{}{"name": "example.com"}
+		testRequestJSON(t, r, want)
+
+		if attempts == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		httpResponse := httpResponseFixture(t, "/api/createDomain/created.http")
+		w.WriteHeader(httpResponse.StatusCode)
+		_, _ = io.Copy(w, httpResponse.Body)
+	})
+
+	_, err := client.Domains.CreateDomain(context.Background(), "1010", Domain{Name: "example.com"})
+	assert.Error(t, err)
+	assert.Equal(t, 1, attempts)
+
+	attempts = 0
+	client.RetryPolicy.RetryNonIdempotent = true
+	_, err = client.Domains.CreateDomain(context.Background(), "1010", Domain{Name: "example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestClient_Retry_MaxWait(t *testing.T) {
+	setupMockServer()
+	defer teardownMockServer()
+	client.RetryPolicy = testRetryPolicy()
+	client.RetryPolicy.MaxWait = time.Second
+
+	attempts := 0
+	mux.HandleFunc("/v2/whoami", func(w http.ResponseWriter, _ *http.Request) {
+		attempts++
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = io.WriteString(w, `{"message":"Too many requests"}`)
+	})
+
+	_, err := client.Identity.Whoami(context.Background())
+
+	var got *ErrorResponse
+	assert.ErrorAs(t, err, &got)
+	assert.Equal(t, http.StatusTooManyRequests, got.HTTPResponse.StatusCode)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestClient_Retry_ContextCanceled(t *testing.T) {
+	setupMockServer()
+	defer teardownMockServer()
+	client.RetryPolicy = testRetryPolicy()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	mux.HandleFunc("/v2/whoami", func(w http.ResponseWriter, _ *http.Request) {
+		cancel()
+		w.Header().Set("Retry-After", "1")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	_, err := client.Identity.Whoami(ctx)
+
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestRetryPolicy_Delay(t *testing.T) {
+	policy := &RetryPolicy{MinBackoff: time.Second, MaxBackoff: 4 * time.Second}
+
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+	resp.Header.Set("Retry-After", "7")
+	assert.Equal(t, 7*time.Second, policy.delay(resp, 1))
+
+	resp.Header.Set("Retry-After", time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+	assert.InDelta(t, time.Hour, policy.delay(resp, 1), float64(2*time.Second))
+
+	resp.Header.Del("Retry-After")
+	resp.Header.Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(10*time.Minute).Unix(), 10))
+	assert.InDelta(t, 10*time.Minute, policy.delay(resp, 1), float64(2*time.Second))
+
+	resp.StatusCode = http.StatusBadGateway
+	for attempt, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 4 * time.Second} {
+		d := policy.delay(resp, attempt+1)
+		assert.GreaterOrEqual(t, d, want/2)
+		assert.LessOrEqual(t, d, want)
+	}
+}
@@ -3,6 +3,7 @@ package dnsimple
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 // ServicesService handles communication with the service related
@@ -69,7 +70,7 @@ func (s *ServicesService) ListServices(ctx context.Context, options *ListOptions
 		return nil, err
 	}
 
-	resp, err := s.client.get(ctx, path, servicesResponse)
+	resp, err := s.client.get(ctx, "Services.ListServices", path, servicesResponse)
 	if err != nil {
 		return servicesResponse, err
 	}
@@ -78,6 +79,28 @@ func (s *ServicesService) ListServices(ctx context.Context, options *ListOptions
 	return servicesResponse, nil
 }
 
+// ListServicesIter returns an iterator over the one-click services available in DNSimple,
+// that fetches the pages lazily as the iteration progresses.
+//
+// See ListServices for the available options, and All to collect the results.
+func (s *ServicesService) ListServicesIter(ctx context.Context, options *ListOptions) iter.Seq2[Service, error] {
+	opts := ListOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	return paginate(ctx, s.client, firstPage(&opts), func(ctx context.Context, page int) ([]Service, *Response, error) {
+		opts := opts
+		opts.Page = &page
+
+		resp, err := s.ListServices(ctx, &opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Data, &resp.Response, nil
+	})
+}
+
 // GetService fetches a one-click service.
 //
 // See https://developer.dnsimple.com/v2/services/#get
@@ -85,7 +108,7 @@ func (s *ServicesService) GetService(ctx context.Context, serviceIdentifier stri
 	path := versioned(servicePath(serviceIdentifier))
 	serviceResponse := &ServiceResponse{}
 
-	resp, err := s.client.get(ctx, path, serviceResponse)
+	resp, err := s.client.get(ctx, "Services.GetService", path, serviceResponse)
 	if err != nil {
 		return nil, err
 	}
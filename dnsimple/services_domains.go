@@ -3,6 +3,7 @@ package dnsimple
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 func domainServicesPath(accountID string, domainIdentifier string, serviceIdentifier string) string {
@@ -29,7 +30,7 @@ func (s *ServicesService) AppliedServices(ctx context.Context, accountID string,
 		return nil, err
 	}
 
-	resp, err := s.client.get(ctx, path, servicesResponse)
+	resp, err := s.client.get(ctx, "Services.AppliedServices", path, servicesResponse)
 	if err != nil {
 		return servicesResponse, err
 	}
@@ -38,6 +39,28 @@ func (s *ServicesService) AppliedServices(ctx context.Context, accountID string,
 	return servicesResponse, nil
 }
 
+// AppliedServicesIter returns an iterator over the applied one-click services for a domain,
+// that fetches the pages lazily as the iteration progresses.
+//
+// See AppliedServices for the available options, and All to collect the results.
+func (s *ServicesService) AppliedServicesIter(ctx context.Context, accountID string, domainIdentifier string, options *ListOptions) iter.Seq2[Service, error] {
+	opts := ListOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	return paginate(ctx, s.client, firstPage(&opts), func(ctx context.Context, page int) ([]Service, *Response, error) {
+		opts := opts
+		opts.Page = &page
+
+		resp, err := s.AppliedServices(ctx, accountID, domainIdentifier, &opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Data, &resp.Response, nil
+	})
+}
+
 // ApplyService applies a one-click services to a domain.
 //
 // See https://developer.dnsimple.com/v2/services/domains/#apply
@@ -45,7 +68,7 @@ func (s *ServicesService) ApplyService(ctx context.Context, accountID string, se
 	path := versioned(domainServicesPath(accountID, domainIdentifier, serviceIdentifier))
 	serviceResponse := &ServiceResponse{}
 
-	resp, err := s.client.post(ctx, path, settings, nil)
+	resp, err := s.client.post(ctx, "Services.ApplyService", path, settings, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -61,7 +84,7 @@ func (s *ServicesService) UnapplyService(ctx context.Context, accountID string,
 	path := versioned(domainServicesPath(accountID, domainIdentifier, serviceIdentifier))
 	serviceResponse := &ServiceResponse{}
 
-	resp, err := s.client.delete(ctx, path, nil, nil)
+	resp, err := s.client.delete(ctx, "Services.UnapplyService", path, nil, nil)
 	if err != nil {
 		return nil, err
 	}
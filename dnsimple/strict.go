@@ -0,0 +1,164 @@
+package dnsimple
+
+import (
+	"context"
+	"encoding"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// UnknownFieldsFunc is called with the paths of the fields of a response body
+// that the result type doesn't decode, e.g. "data.new_attribute" or "data[].new_attribute".
+type UnknownFieldsFunc func(ctx context.Context, req *http.Request, fields []string)
+
+// LogUnknownFields returns an UnknownFieldsFunc that logs the unknown fields with the logger,
+// at slog.LevelWarn.
+func LogUnknownFields(logger *slog.Logger) UnknownFieldsFunc {
+	return func(ctx context.Context, req *http.Request, fields []string) {
+		logger.LogAttrs(ctx, slog.LevelWarn, "dnsimple: unknown fields in response",
+			slog.String("method", req.Method),
+			slog.String("path", req.URL.Path),
+			slog.Any("fields", fields),
+		)
+	}
+}
+
+// WithStrictDecoding reports the fields of the response bodies that the result types
+// don't decode, to detect the attributes the API added since the client was released.
+// The calls don't fail because of unknown fields.
+//
+// The fields are reported to fn or, when fn is nil, logged with the client Logger,
+// or slog.Default() when the client has no logger. See Client.UnknownFields.
+func WithStrictDecoding(fn UnknownFieldsFunc) Option {
+	return withClient(func(c *Client) {
+		if fn == nil {
+			// The logger is looked up on every report, as the client Logger can be set later.
+			fn = func(ctx context.Context, req *http.Request, fields []string) {
+				logger := c.logger()
+				if logger == nil {
+					logger = slog.Default()
+				}
+				LogUnknownFields(logger)(ctx, req, fields)
+			}
+		}
+		c.UnknownFields = fn
+	})
+}
+
+// reportUnknownFields reports the unknown fields of the response body, if any.
+func (c *Client) reportUnknownFields(ctx context.Context, req *http.Request, data []byte, obj interface{}) {
+	if c.UnknownFields == nil {
+		return
+	}
+	fields, err := UnknownFields(data, obj)
+	if err != nil || len(fields) == 0 {
+		return
+	}
+	c.UnknownFields(ctx, req, fields)
+}
+
+var (
+	jsonUnmarshalerType = reflect.TypeFor[json.Unmarshaler]()
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+)
+
+// UnknownFields returns the paths of the fields of the JSON data that json.Unmarshal
+// would ignore when decoding into v, sorted.
+//
+// The elements of the arrays share the same path, e.g. "data[].name",
+// and the types that implement json.Unmarshaler are not inspected.
+func UnknownFields(data []byte, v interface{}) ([]string, error) {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+
+	fields := map[string]bool{}
+	collectUnknownFields(value, reflect.TypeOf(v), "", fields)
+
+	paths := make([]string, 0, len(fields))
+	for path := range fields {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+	return paths, nil
+}
+
+// collectUnknownFields adds to fields the paths of the fields of value that t doesn't decode.
+func collectUnknownFields(value interface{}, t reflect.Type, path string, fields map[string]bool) {
+	if t == nil {
+		return
+	}
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() == reflect.Interface ||
+		reflect.PointerTo(t).Implements(jsonUnmarshalerType) ||
+		reflect.PointerTo(t).Implements(textUnmarshalerType) {
+		return
+	}
+
+	switch value := value.(type) {
+	case map[string]interface{}:
+		switch t.Kind() {
+		case reflect.Struct:
+			known := jsonFields(t)
+			for name, fieldValue := range value {
+				field, ok := known[name]
+				if !ok {
+					field, ok = known[strings.ToLower(name)]
+				}
+				if !ok {
+					fields[joinPath(path, name)] = true
+					continue
+				}
+				collectUnknownFields(fieldValue, field.Type, joinPath(path, name), fields)
+			}
+		case reflect.Map:
+			for name, fieldValue := range value {
+				collectUnknownFields(fieldValue, t.Elem(), joinPath(path, name), fields)
+			}
+		}
+	case []interface{}:
+		if t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+			return
+		}
+		for _, element := range value {
+			collectUnknownFields(element, t.Elem(), path+"[]", fields)
+		}
+	}
+}
+
+// jsonFields returns the fields of the struct type decoded by encoding/json,
+// by JSON name and by lowercase JSON name, as encoding/json matches the names case-insensitively.
+func jsonFields(t reflect.Type) map[string]reflect.StructField {
+	fields := map[string]reflect.StructField{}
+	for _, field := range reflect.VisibleFields(t) {
+		if !field.IsExported() || field.Anonymous && field.Tag.Get("json") == "" {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = field
+		if _, ok := fields[strings.ToLower(name)]; !ok {
+			fields[strings.ToLower(name)] = field
+		}
+	}
+	return fields
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -0,0 +1,318 @@
+package dnsimple
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fixtureResponses are the response types of the fixtures, by operation.
+var fixtureResponses = map[string]func() interface{}{
+	"acceptPush":                            func() interface{} { return &DomainPushResponse{} },
+	"accounts":                              func() interface{} { return &AccountsResponse{} },
+	"activateZoneService":                   func() interface{} { return &ZoneResponse{} },
+	"appliedServices":                       func() interface{} { return &ServicesResponse{} },
+	"applyService":                          func() interface{} { return &ServiceResponse{} },
+	"applyTemplate":                         func() interface{} { return &TemplateResponse{} },
+	"authorizeDomainTransferOut":            func() interface{} { return &DomainTransferOutResponse{} },
+	"batchChangeZoneRecords":                func() interface{} { return &BatchChangeZoneRecordsResponse{} },
+	"cancelDomainTransfer":                  func() interface{} { return &DomainTransferResponse{} },
+	"changeDomainDelegation":                func() interface{} { return &DelegationResponse{} },
+	"changeDomainDelegationFromVanity":      func() interface{} { return &VanityDelegationResponse{} },
+	"changeDomainDelegationToVanity":        func() interface{} { return &VanityDelegationResponse{} },
+	"checkDomain":                           func() interface{} { return &DomainCheckResponse{} },
+	"checkRegistrantChange":                 func() interface{} { return &RegistrantChangeCheckResponse{} },
+	"checkZoneDistribution":                 func() interface{} { return &ZoneDistributionResponse{} },
+	"checkZoneRecordDistribution":           func() interface{} { return &ZoneDistributionResponse{} },
+	"createContact":                         func() interface{} { return &ContactResponse{} },
+	"createDelegationSignerRecord":          func() interface{} { return &DelegationSignerRecordResponse{} },
+	"createDomain":                          func() interface{} { return &DomainResponse{} },
+	"createEmailForward":                    func() interface{} { return &EmailForwardResponse{} },
+	"createRegistrantChange":                func() interface{} { return &RegistrantChangeResponse{} },
+	"createTemplate":                        func() interface{} { return &TemplateResponse{} },
+	"createTemplateRecord":                  func() interface{} { return &TemplateRecordResponse{} },
+	"createWebhook":                         func() interface{} { return &WebhookResponse{} },
+	"createZoneRecord":                      func() interface{} { return &ZoneRecordResponse{} },
+	"deactivateZoneService":                 func() interface{} { return &ZoneResponse{} },
+	"deleteContact":                         func() interface{} { return &ContactResponse{} },
+	"deleteDelegationSignerRecord":          func() interface{} { return &DelegationSignerRecordResponse{} },
+	"deleteDomain":                          func() interface{} { return &DomainResponse{} },
+	"deleteEmailForward":                    func() interface{} { return &EmailForwardResponse{} },
+	"deleteRegistrantChange":                func() interface{} { return &RegistrantChangeDeleteResponse{} },
+	"deleteTemplate":                        func() interface{} { return &TemplateResponse{} },
+	"deleteTemplateRecord":                  func() interface{} { return &TemplateRecordResponse{} },
+	"deleteWebhook":                         func() interface{} { return &WebhookResponse{} },
+	"deleteZoneRecord":                      func() interface{} { return &ZoneRecordResponse{} },
+	"disableDnssec":                         func() interface{} { return &DnssecResponse{} },
+	"disableDomainAutoRenewal":              func() interface{} { return &DomainResponse{} },
+	"disableDomainTransferLock":             func() interface{} { return &DomainTransferLockResponse{} },
+	"disableVanityNameServers":              func() interface{} { return &VanityNameServerResponse{} },
+	"disableWhoisPrivacy":                   func() interface{} { return &WhoisPrivacyResponse{} },
+	"dnsAnalytics":                          func() interface{} { return &DnsAnalyticsResponse{} },
+	"downloadCertificate":                   func() interface{} { return &CertificateBundleResponse{} },
+	"enableDnssec":                          func() interface{} { return &DnssecResponse{} },
+	"enableDomainAutoRenewal":               func() interface{} { return &DomainResponse{} },
+	"enableDomainTransferLock":              func() interface{} { return &DomainTransferLockResponse{} },
+	"enableVanityNameServers":               func() interface{} { return &VanityNameServerResponse{} },
+	"enableWhoisPrivacy":                    func() interface{} { return &WhoisPrivacyResponse{} },
+	"getCertificate":                        func() interface{} { return &CertificateResponse{} },
+	"getCertificatePrivateKey":              func() interface{} { return &CertificateBundleResponse{} },
+	"getContact":                            func() interface{} { return &ContactResponse{} },
+	"getDelegationSignerRecord":             func() interface{} { return &DelegationSignerRecordResponse{} },
+	"getDnssec":                             func() interface{} { return &DnssecResponse{} },
+	"getDomain":                             func() interface{} { return &DomainResponse{} },
+	"getDomainDelegation":                   func() interface{} { return &DelegationResponse{} },
+	"getDomainPrices":                       func() interface{} { return &DomainPriceResponse{} },
+	"getDomainRegistration":                 func() interface{} { return &DomainRegistrationResponse{} },
+	"getDomainRenewal":                      func() interface{} { return &DomainRenewalResponse{} },
+	"getDomainRestore":                      func() interface{} { return &DomainRestoreResponse{} },
+	"getDomainTransfer":                     func() interface{} { return &DomainTransferResponse{} },
+	"getDomainTransferLock":                 func() interface{} { return &DomainTransferLockResponse{} },
+	"getDomainsResearchStatus":              func() interface{} { return &DomainResearchStatusResponse{} },
+	"getEmailForward":                       func() interface{} { return &EmailForwardResponse{} },
+	"getRegistrantChange":                   func() interface{} { return &RegistrantChangeResponse{} },
+	"getService":                            func() interface{} { return &ServiceResponse{} },
+	"getTemplate":                           func() interface{} { return &TemplateResponse{} },
+	"getTemplateRecord":                     func() interface{} { return &TemplateRecordResponse{} },
+	"getTld":                                func() interface{} { return &TldResponse{} },
+	"getTldExtendedAttributes":              func() interface{} { return &TldExtendedAttributesResponse{} },
+	"getWebhook":                            func() interface{} { return &WebhookResponse{} },
+	"getZone":                               func() interface{} { return &ZoneResponse{} },
+	"getZoneFile":                           func() interface{} { return &ZoneFileResponse{} },
+	"getZoneRecord":                         func() interface{} { return &ZoneRecordResponse{} },
+	"initiatePush":                          func() interface{} { return &DomainPushResponse{} },
+	"issueLetsencryptCertificate":           func() interface{} { return &CertificateResponse{} },
+	"issueRenewalLetsencryptCertificate":    func() interface{} { return &CertificateResponse{} },
+	"listAccounts":                          func() interface{} { return &AccountsResponse{} },
+	"listCertificates":                      func() interface{} { return &CertificatesResponse{} },
+	"listCharges":                           func() interface{} { return &ListChargesResponse{} },
+	"listContacts":                          func() interface{} { return &ContactsResponse{} },
+	"listDelegationSignerRecords":           func() interface{} { return &DelegationSignerRecordsResponse{} },
+	"listDomains":                           func() interface{} { return &DomainsResponse{} },
+	"listEmailForwards":                     func() interface{} { return &EmailForwardsResponse{} },
+	"listPushes":                            func() interface{} { return &DomainPushesResponse{} },
+	"listRegistrantChanges":                 func() interface{} { return &RegistrantChangesListResponse{} },
+	"listServices":                          func() interface{} { return &ServicesResponse{} },
+	"listTemplateRecords":                   func() interface{} { return &TemplateRecordsResponse{} },
+	"listTemplates":                         func() interface{} { return &TemplatesResponse{} },
+	"listTlds":                              func() interface{} { return &TldsResponse{} },
+	"listWebhooks":                          func() interface{} { return &WebhooksResponse{} },
+	"listZoneRecords":                       func() interface{} { return &ZoneRecordsResponse{} },
+	"listZones":                             func() interface{} { return &ZonesResponse{} },
+	"oauthAccessToken":                      func() interface{} { return &AccessToken{} },
+	"purchaseLetsencryptCertificate":        func() interface{} { return &CertificatePurchaseResponse{} },
+	"purchaseRenewalLetsencryptCertificate": func() interface{} { return &CertificateRenewalResponse{} },
+	"registerDomain":                        func() interface{} { return &DomainRegistrationResponse{} },
+	"rejectPush":                            func() interface{} { return &DomainPushResponse{} },
+	"renewDomain":                           func() interface{} { return &DomainRenewalResponse{} },
+	"restoreDomain":                         func() interface{} { return &DomainRestoreResponse{} },
+	"transferDomain":                        func() interface{} { return &DomainTransferResponse{} },
+	"unapplyService":                        func() interface{} { return &ServiceResponse{} },
+	"updateContact":                         func() interface{} { return &ContactResponse{} },
+	"updateTemplate":                        func() interface{} { return &TemplateResponse{} },
+	"updateZoneRecord":                      func() interface{} { return &ZoneRecordResponse{} },
+	"whoami":                                func() interface{} { return &WhoamiResponse{} },
+}
+
+// unsupportedFixtures are the operations with fixtures that the client doesn't implement.
+var unsupportedFixtures = []string{
+	"createPrimaryServer",
+	"createSecondaryZone",
+	"getPrimaryServer",
+	"linkPrimaryServer",
+	"listPrimaryServers",
+	"unlinkPrimaryServer",
+	"updateZoneNsRecords",
+}
+
+// ignoredFixtureFields are the fields of the fixtures that the client deliberately doesn't decode, by operation.
+var ignoredFixtureFields = map[string][]string{
+	// expires_on is deprecated in favor of expires_at.
+	"createDomain": {"data.expires_on"},
+	"getDomain":    {"data.expires_on"},
+	"listDomains":  {"data[].expires_on"},
+	// expires_on, name and contact_id are deprecated certificate attributes.
+	"getCertificate":                     {"data.contact_id", "data.expires_on", "data.name"},
+	"issueLetsencryptCertificate":        {"data.contact_id", "data.expires_on", "data.name"},
+	"issueRenewalLetsencryptCertificate": {"data.contact_id", "data.expires_on", "data.name"},
+	"listCertificates":                   {"data[].contact_id", "data[].expires_on", "data[].name"},
+	// The name server limits are not used by the client. idn is not decoded yet.
+	"getTld":   {"data.idn", "data.name_server_max", "data.name_server_min"},
+	"listTlds": {"data[].idn", "data[].name_server_max", "data[].name_server_min"},
+	// The pending registrant change of an asynchronous deletion is not returned.
+	"deleteRegistrantChange": {"data"},
+	// The research status of a validation error is not returned.
+	"getDomainsResearchStatus": {"data"},
+	// These attributes are not decoded yet: adding them to the models is a separate change.
+	"enableDnssec":     {"data.active", "data.created_at", "data.updated_at"},
+	"getDnssec":        {"data.active", "data.created_at", "data.updated_at"},
+	"createWebhook":    {"data.suppressed_at"},
+	"getWebhook":       {"data.suppressed_at"},
+	"listWebhooks":     {"data[].suppressed_at"},
+	"oauthAccessToken": {"scope"},
+	"whoami":           {"data.user.created_at", "data.user.updated_at"},
+}
+
+// fixtureErrorResponse returns the type of the error response of the fixture.
+func fixtureErrorResponse(operation string) interface{} {
+	switch operation {
+	case "oauthAccessToken":
+		return &ExchangeAuthorizationError{}
+	case "batchChangeZoneRecords":
+		return &batchChangeZoneRecordsErrorResponse{}
+	default:
+		return &ErrorResponse{}
+	}
+}
+
+// TestFixtures_UnknownFields decodes every API fixture with its response type,
+// and fails on the fields that the type doesn't decode, so that the attributes
+// added to the API show up as soon as the fixtures are updated.
+func TestFixtures_UnknownFields(t *testing.T) {
+	paths, err := filepath.Glob("../fixtures.http/api/*/*.http")
+	require.NoError(t, err)
+	require.NotEmpty(t, paths)
+
+	for _, path := range paths {
+		operation := filepath.Base(filepath.Dir(path))
+		name := operation + "/" + filepath.Base(path)
+
+		t.Run(name, func(t *testing.T) {
+			if slices.Contains(unsupportedFixtures, operation) {
+				t.Skipf("operation %s is not supported by the client", operation)
+			}
+			newResponse, ok := fixtureResponses[operation]
+			require.True(t, ok, "no response type for the fixtures of %s: add it to fixtureResponses", operation)
+
+			resp := httpResponseFixture(t, "/api/"+name)
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			if len(body) == 0 {
+				return
+			}
+
+			response := newResponse()
+			if resp.StatusCode >= http.StatusBadRequest {
+				response = fixtureErrorResponse(operation)
+			}
+			fields, err := UnknownFields(body, response)
+			require.NoError(t, err)
+			fields = slices.DeleteFunc(fields, func(field string) bool {
+				return slices.Contains(ignoredFixtureFields[operation], field)
+			})
+			assert.Empty(t, fields, "fields of %s not decoded by %T", name, response)
+		})
+	}
+}
+
+func TestUnknownFields(t *testing.T) {
+	type record struct {
+		ID       int64             `json:"id"`
+		Name     string            `json:"name,omitempty"`
+		Ignored  string            `json:"-"`
+		Tags     map[string]string `json:"tags"`
+		Internal string
+	}
+	type response struct {
+		Response
+		Data []record `json:"data"`
+	}
+
+	fields, err := UnknownFields([]byte(`{
+		"data": [
+			{"id": 1, "NAME": "a", "internal": "x", "tags": {"env": "prod"}, "new": true},
+			{"id": 2, "Ignored": "y", "new": false, "newer": {"a": 1}}
+		],
+		"pagination": {"current_page": 1, "per_page": 30, "total_entries": 2, "total_pages": 1, "next": null},
+		"meta": {}
+	}`), &response{})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"data[].Ignored", "data[].new", "data[].newer", "meta", "pagination.next"}, fields)
+
+	_, err = UnknownFields([]byte(`{`), &response{})
+	assert.Error(t, err)
+}
+
+func TestClient_WithStrictDecoding(t *testing.T) {
+	setupMockServer()
+	defer teardownMockServer()
+
+	mux.HandleFunc("/v2/1010/zones/example.com", func(w http.ResponseWriter, r *http.Request) {
+		resp := httpResponseFixture(t, "/api/getZone/success.http")
+		body, _ := io.ReadAll(resp.Body)
+
+		w.WriteHeader(resp.StatusCode)
+		_, _ = io.WriteString(w, strings.Replace(string(body), `"active":true`, `"active":true,"dnssec":false`, 1))
+	})
+
+	var reported []string
+	var reportedPath string
+	c := NewClient(http.DefaultClient, WithBaseURL(server.URL), WithStrictDecoding(func(ctx context.Context, req *http.Request, fields []string) {
+		reportedPath = req.URL.Path
+		reported = fields
+	}))
+
+	zoneResponse, err := c.Zones.GetZone(context.Background(), "1010", "example.com")
+
+	require.NoError(t, err)
+	assert.True(t, zoneResponse.Data.Active)
+	assert.Equal(t, "/v2/1010/zones/example.com", reportedPath)
+	assert.Equal(t, []string{"data.dnssec"}, reported)
+}
+
+func TestClient_WithStrictDecoding_Logger(t *testing.T) {
+	setupMockServer()
+	defer teardownMockServer()
+
+	mux.HandleFunc("/v2/whoami", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = io.WriteString(w, `{"data":{"user":null,"account":null,"plan":"teams"}}`)
+	})
+
+	logger, buf := testLogger(slog.LevelWarn)
+	c := NewClient(http.DefaultClient, WithBaseURL(server.URL), WithLogger(logger), WithStrictDecoding(nil))
+
+	_, err := c.Identity.Whoami(context.Background())
+	require.NoError(t, err)
+
+	var entry map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(t, "WARN", entry["level"])
+	assert.Equal(t, "dnsimple: unknown fields in response", entry["msg"])
+	assert.Equal(t, "/v2/whoami", entry["path"])
+	assert.Equal(t, []interface{}{"data.plan"}, entry["fields"])
+}
+
+func TestClient_WithStrictDecoding_LoggerSetLater(t *testing.T) {
+	setupMockServer()
+	defer teardownMockServer()
+
+	mux.HandleFunc("/v2/whoami", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = io.WriteString(w, `{"data":{"user":null,"account":null,"plan":"teams"}}`)
+	})
+
+	optionLogger, optionBuf := testLogger(slog.LevelWarn)
+	c := NewClient(http.DefaultClient, WithBaseURL(server.URL), WithStrictDecoding(nil), WithLogger(optionLogger))
+
+	_, err := c.Identity.Whoami(context.Background())
+	require.NoError(t, err)
+	assert.Contains(t, optionBuf.String(), "dnsimple: unknown fields in response")
+
+	logger, buf := testLogger(slog.LevelWarn)
+	c.Logger = logger
+
+	_, err = c.Identity.Whoami(context.Background())
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), `"fields":["data.plan"]`)
+}
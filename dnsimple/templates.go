@@ -3,6 +3,7 @@ package dnsimple
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 // TemplatesService handles communication with the template related
@@ -56,7 +57,7 @@ func (s *TemplatesService) ListTemplates(ctx context.Context, accountID string,
 		return nil, err
 	}
 
-	resp, err := s.client.get(ctx, path, templatesResponse)
+	resp, err := s.client.get(ctx, "Templates.ListTemplates", path, templatesResponse)
 	if err != nil {
 		return templatesResponse, err
 	}
@@ -65,6 +66,28 @@ func (s *TemplatesService) ListTemplates(ctx context.Context, accountID string,
 	return templatesResponse, nil
 }
 
+// ListTemplatesIter returns an iterator over the templates for an account,
+// that fetches the pages lazily as the iteration progresses.
+//
+// See ListTemplates for the available options, and All to collect the results.
+func (s *TemplatesService) ListTemplatesIter(ctx context.Context, accountID string, options *ListOptions) iter.Seq2[Template, error] {
+	opts := ListOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	return paginate(ctx, s.client, firstPage(&opts), func(ctx context.Context, page int) ([]Template, *Response, error) {
+		opts := opts
+		opts.Page = &page
+
+		resp, err := s.ListTemplates(ctx, accountID, &opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Data, &resp.Response, nil
+	})
+}
+
 // CreateTemplate creates a new template.
 //
 // See https://developer.dnsimple.com/v2/templates/#create
@@ -72,7 +95,7 @@ func (s *TemplatesService) CreateTemplate(ctx context.Context, accountID string,
 	path := versioned(templatePath(accountID, ""))
 	templateResponse := &TemplateResponse{}
 
-	resp, err := s.client.post(ctx, path, templateAttributes, templateResponse)
+	resp, err := s.client.post(ctx, "Templates.CreateTemplate", path, templateAttributes, templateResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -88,7 +111,7 @@ func (s *TemplatesService) GetTemplate(ctx context.Context, accountID string, te
 	path := versioned(templatePath(accountID, templateIdentifier))
 	templateResponse := &TemplateResponse{}
 
-	resp, err := s.client.get(ctx, path, templateResponse)
+	resp, err := s.client.get(ctx, "Templates.GetTemplate", path, templateResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -104,7 +127,7 @@ func (s *TemplatesService) UpdateTemplate(ctx context.Context, accountID string,
 	path := versioned(templatePath(accountID, templateIdentifier))
 	templateResponse := &TemplateResponse{}
 
-	resp, err := s.client.patch(ctx, path, templateAttributes, templateResponse)
+	resp, err := s.client.patch(ctx, "Templates.UpdateTemplate", path, templateAttributes, templateResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -120,7 +143,7 @@ func (s *TemplatesService) DeleteTemplate(ctx context.Context, accountID string,
 	path := versioned(templatePath(accountID, templateIdentifier))
 	templateResponse := &TemplateResponse{}
 
-	resp, err := s.client.delete(ctx, path, nil, nil)
+	resp, err := s.client.delete(ctx, "Templates.DeleteTemplate", path, nil, nil)
 	if err != nil {
 		return nil, err
 	}
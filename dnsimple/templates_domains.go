@@ -12,7 +12,7 @@ func (s *TemplatesService) ApplyTemplate(ctx context.Context, accountID string,
 	path := versioned(fmt.Sprintf("%v/templates/%v", domainPath(accountID, domainIdentifier), templateIdentifier))
 	templateResponse := &TemplateResponse{}
 
-	resp, err := s.client.post(ctx, path, nil, nil)
+	resp, err := s.client.post(ctx, "Templates.ApplyTemplate", path, nil, nil)
 	if err != nil {
 		return nil, err
 	}
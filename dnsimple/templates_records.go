@@ -3,6 +3,7 @@ package dnsimple
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 // TemplateRecord represents a DNS record for a template in DNSimple.
@@ -50,7 +51,7 @@ func (s *TemplatesService) ListTemplateRecords(ctx context.Context, accountID st
 		return nil, err
 	}
 
-	resp, err := s.client.get(ctx, path, templateRecordsResponse)
+	resp, err := s.client.get(ctx, "Templates.ListTemplateRecords", path, templateRecordsResponse)
 	if err != nil {
 		return templateRecordsResponse, err
 	}
@@ -59,6 +60,28 @@ func (s *TemplatesService) ListTemplateRecords(ctx context.Context, accountID st
 	return templateRecordsResponse, nil
 }
 
+// ListTemplateRecordsIter returns an iterator over the records for a template,
+// that fetches the pages lazily as the iteration progresses.
+//
+// See ListTemplateRecords for the available options, and All to collect the results.
+func (s *TemplatesService) ListTemplateRecordsIter(ctx context.Context, accountID string, templateIdentifier string, options *ListOptions) iter.Seq2[TemplateRecord, error] {
+	opts := ListOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	return paginate(ctx, s.client, firstPage(&opts), func(ctx context.Context, page int) ([]TemplateRecord, *Response, error) {
+		opts := opts
+		opts.Page = &page
+
+		resp, err := s.ListTemplateRecords(ctx, accountID, templateIdentifier, &opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Data, &resp.Response, nil
+	})
+}
+
 // CreateTemplateRecord creates a new template record.
 //
 // See https://developer.dnsimple.com/v2/templates/records/#create
@@ -66,7 +89,7 @@ func (s *TemplatesService) CreateTemplateRecord(ctx context.Context, accountID s
 	path := versioned(templateRecordPath(accountID, templateIdentifier, 0))
 	templateRecordResponse := &TemplateRecordResponse{}
 
-	resp, err := s.client.post(ctx, path, templateRecordAttributes, templateRecordResponse)
+	resp, err := s.client.post(ctx, "Templates.CreateTemplateRecord", path, templateRecordAttributes, templateRecordResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -82,7 +105,7 @@ func (s *TemplatesService) GetTemplateRecord(ctx context.Context, accountID stri
 	path := versioned(templateRecordPath(accountID, templateIdentifier, templateRecordID))
 	templateRecordResponse := &TemplateRecordResponse{}
 
-	resp, err := s.client.get(ctx, path, templateRecordResponse)
+	resp, err := s.client.get(ctx, "Templates.GetTemplateRecord", path, templateRecordResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -98,7 +121,7 @@ func (s *TemplatesService) DeleteTemplateRecord(ctx context.Context, accountID s
 	path := versioned(templateRecordPath(accountID, templateIdentifier, templateRecordID))
 	templateRecordResponse := &TemplateRecordResponse{}
 
-	resp, err := s.client.delete(ctx, path, nil, nil)
+	resp, err := s.client.delete(ctx, "Templates.DeleteTemplateRecord", path, nil, nil)
 	if err != nil {
 		return nil, err
 	}
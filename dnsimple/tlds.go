@@ -3,6 +3,7 @@ package dnsimple
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 // TldsService handles communication with the Tld related
@@ -79,7 +80,7 @@ func (s *TldsService) ListTlds(ctx context.Context, options *ListOptions) (*Tlds
 		return nil, err
 	}
 
-	resp, err := s.client.get(ctx, path, tldsResponse)
+	resp, err := s.client.get(ctx, "Tlds.ListTlds", path, tldsResponse)
 	if err != nil {
 		return tldsResponse, err
 	}
@@ -88,6 +89,28 @@ func (s *TldsService) ListTlds(ctx context.Context, options *ListOptions) (*Tlds
 	return tldsResponse, nil
 }
 
+// ListTldsIter returns an iterator over the TLDs supported for registration or transfer,
+// that fetches the pages lazily as the iteration progresses.
+//
+// See ListTlds for the available options, and All to collect the results.
+func (s *TldsService) ListTldsIter(ctx context.Context, options *ListOptions) iter.Seq2[Tld, error] {
+	opts := ListOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	return paginate(ctx, s.client, firstPage(&opts), func(ctx context.Context, page int) ([]Tld, *Response, error) {
+		opts := opts
+		opts.Page = &page
+
+		resp, err := s.ListTlds(ctx, &opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Data, &resp.Response, nil
+	})
+}
+
 // GetTld fetches a TLD.
 //
 // See https://developer.dnsimple.com/v2/tlds/#get
@@ -95,7 +118,7 @@ func (s *TldsService) GetTld(ctx context.Context, tld string) (*TldResponse, err
 	path := versioned(fmt.Sprintf("/tlds/%s", tld))
 	tldResponse := &TldResponse{}
 
-	resp, err := s.client.get(ctx, path, tldResponse)
+	resp, err := s.client.get(ctx, "Tlds.GetTld", path, tldResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -111,7 +134,7 @@ func (s *TldsService) GetTldExtendedAttributes(ctx context.Context, tld string)
 	path := versioned(fmt.Sprintf("/tlds/%s/extended_attributes", tld))
 	tldResponse := &TldExtendedAttributesResponse{}
 
-	resp, err := s.client.get(ctx, path, tldResponse)
+	resp, err := s.client.get(ctx, "Tlds.GetTldExtendedAttributes", path, tldResponse)
 	if err != nil {
 		return nil, err
 	}
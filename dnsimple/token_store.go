@@ -0,0 +1,258 @@
+package dnsimple
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// ErrNoValidToken is returned when the token store has no token to try.
+var ErrNoValidToken = errors.New("dnsimple: no valid token in the token store")
+
+// TokenStore provides the API tokens to authenticate with.
+//
+// A store can hold several tokens: the first one is used, and the following ones
+// are the fallbacks used when the API rejects the previous ones.
+// Tokens is called before every request, so an implementation that reads
+// an external source should cache it, and reload it only when it changes.
+type TokenStore interface {
+	Tokens(ctx context.Context) ([]string, error)
+}
+
+// MemoryTokenStore is a TokenStore that keeps the tokens in memory.
+// It is safe for concurrent use.
+type MemoryTokenStore struct {
+	mu     sync.RWMutex
+	tokens []string
+}
+
+// NewMemoryTokenStore returns a MemoryTokenStore with the given tokens.
+func NewMemoryTokenStore(tokens ...string) *MemoryTokenStore {
+	return &MemoryTokenStore{tokens: slices.Clone(tokens)}
+}
+
+// Tokens implements the TokenStore interface.
+func (s *MemoryTokenStore) Tokens(_ context.Context) ([]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return slices.Clone(s.tokens), nil
+}
+
+// SetTokens replaces the tokens of the store.
+func (s *MemoryTokenStore) SetTokens(tokens ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tokens = slices.Clone(tokens)
+}
+
+// EnvTokenStore is a TokenStore that reads the tokens from an environment variable,
+// as a comma-separated list.
+type EnvTokenStore struct {
+	// Name is the name of the environment variable. Defaults to DNSIMPLE_TOKEN.
+	Name string
+}
+
+// Tokens implements the TokenStore interface.
+func (s EnvTokenStore) Tokens(_ context.Context) ([]string, error) {
+	name := s.Name
+	if name == "" {
+		name = EnvToken
+	}
+
+	var tokens []string
+	for _, token := range strings.Split(os.Getenv(name), ",") {
+		if token = strings.TrimSpace(token); token != "" {
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens, nil
+}
+
+// FileTokenStore is a TokenStore that reads the tokens from a file, one per line.
+// Empty lines and lines starting with # are ignored.
+//
+// The file is read again when its modification time or size changes,
+// so the tokens can be rotated by rewriting the file.
+type FileTokenStore struct {
+	Path string
+
+	mu      sync.Mutex
+	modTime time.Time
+	size    int64
+	tokens  []string
+}
+
+// NewFileTokenStore returns a FileTokenStore that reads the tokens from the file at path.
+func NewFileTokenStore(path string) *FileTokenStore {
+	return &FileTokenStore{Path: path}
+}
+
+// Tokens implements the TokenStore interface.
+func (s *FileTokenStore) Tokens(_ context.Context) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	info, err := os.Stat(s.Path)
+	if err != nil {
+		return nil, err
+	}
+	if s.tokens != nil && info.ModTime().Equal(s.modTime) && info.Size() == s.size {
+		return slices.Clone(s.tokens), nil
+	}
+
+	data, err := os.ReadFile(s.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := []string{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		tokens = append(tokens, line)
+	}
+
+	s.tokens, s.modTime, s.size = tokens, info.ModTime(), info.Size()
+	return slices.Clone(s.tokens), nil
+}
+
+// TokenSource returns the current token of a TokenStore.
+//
+// The current token is the first token of the store that has not been rejected by the API.
+// When all the tokens have been rejected, the last one is still used: a rejection may be transient,
+// and the API is the judge of whether the token is valid again.
+// When the tokens of the store change, the rejected tokens are forgotten.
+// It is safe for concurrent use.
+type TokenSource struct {
+	store TokenStore
+
+	mu       sync.Mutex
+	tokens   []string
+	rejected map[string]bool
+}
+
+// NewTokenSource returns a TokenSource that reads the tokens from the store.
+func NewTokenSource(store TokenStore) *TokenSource {
+	return &TokenSource{store: store, rejected: map[string]bool{}}
+}
+
+// Current returns the current token.
+func (s *TokenSource) Current(ctx context.Context) (string, error) {
+	tokens, err := s.store.Tokens(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !slices.Equal(tokens, s.tokens) {
+		s.tokens = tokens
+		clear(s.rejected)
+	}
+	if len(tokens) == 0 {
+		return "", ErrNoValidToken
+	}
+	for _, token := range tokens {
+		if !s.rejected[token] {
+			return token, nil
+		}
+	}
+	return tokens[len(tokens)-1], nil
+}
+
+// Reject marks the token as rejected by the API, so that the next token of the store is used.
+func (s *TokenSource) Reject(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rejected[token] = true
+}
+
+// Token implements the oauth2.TokenSource interface.
+func (s *TokenSource) Token() (*oauth2.Token, error) {
+	token, err := s.Current(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	return &oauth2.Token{AccessToken: token, TokenType: "Bearer"}, nil
+}
+
+// TokenStoreTransport is an http.RoundTripper that authenticates the requests
+// with the current token of a TokenSource.
+//
+// When the API responds with 401 Unauthorized, the token is rejected
+// and the request is sent again with the next token of the store, if any.
+// Otherwise the 401 response is returned, and the next requests use the last token again.
+type TokenStoreTransport struct {
+	Source *TokenSource
+
+	// Transport is the transport RoundTripper used to make HTTP requests.
+	// If nil, http.DefaultTransport is used.
+	Transport http.RoundTripper
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (t *TokenStoreTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport := t.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	for attempt := 0; ; attempt++ {
+		token, err := t.Source.Current(req.Context())
+		if err != nil {
+			if req.Body != nil {
+				req.Body.Close()
+			}
+			return nil, err
+		}
+
+		req2 := cloneRequest(req) // per RoundTripper contract
+		req2.Header.Set("Authorization", "Bearer "+token)
+		if attempt > 0 && req.Body != nil && req.Body != http.NoBody {
+			if req2.Body, err = req.GetBody(); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := transport.RoundTrip(req2)
+		if err != nil || resp.StatusCode != http.StatusUnauthorized {
+			return resp, err
+		}
+
+		t.Source.Reject(token)
+		if !t.canRetry(req) {
+			return resp, nil
+		}
+		if next, err := t.Source.Current(req.Context()); err != nil || next == token {
+			// No other token to try: return the 401 response.
+			return resp, nil
+		}
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+	}
+}
+
+// canRetry reports whether the request can be sent again.
+func (t *TokenStoreTransport) canRetry(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// TokenStoreHTTPClient returns a client that authenticates with the current token of the store,
+// and falls back to the next token when the API rejects it.
+func TokenStoreHTTPClient(store TokenStore) *http.Client {
+	return &http.Client{Transport: &TokenStoreTransport{Source: NewTokenSource(store)}}
+}
@@ -0,0 +1,169 @@
+package dnsimple
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryTokenStore(t *testing.T) {
+	store := NewMemoryTokenStore("token1", "token2")
+
+	tokens, err := store.Tokens(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"token1", "token2"}, tokens)
+
+	store.SetTokens("token3")
+	tokens, err = store.Tokens(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"token3"}, tokens)
+}
+
+func TestEnvTokenStore(t *testing.T) {
+	t.Setenv(EnvToken, "token1, token2,")
+	t.Setenv("TEAM_TOKENS", "token3")
+
+	tokens, err := EnvTokenStore{}.Tokens(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"token1", "token2"}, tokens)
+
+	tokens, err = EnvTokenStore{Name: "TEAM_TOKENS"}.Tokens(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"token3"}, tokens)
+}
+
+func TestFileTokenStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tokens")
+	require.NoError(t, os.WriteFile(path, []byte("# primary\ntoken1\n\ntoken2\n"), 0o600))
+	store := NewFileTokenStore(path)
+
+	tokens, err := store.Tokens(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"token1", "token2"}, tokens)
+
+	require.NoError(t, os.WriteFile(path, []byte("token3\n"), 0o600))
+	// Make sure the modification time changes, whatever the resolution of the file system.
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(time.Minute)))
+
+	tokens, err = store.Tokens(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"token3"}, tokens)
+
+	_, err = NewFileTokenStore(filepath.Join(t.TempDir(), "missing")).Tokens(context.Background())
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestTokenSource(t *testing.T) {
+	store := NewMemoryTokenStore("token1", "token2")
+	source := NewTokenSource(store)
+
+	token, err := source.Current(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "token1", token)
+
+	source.Reject("token1")
+	token, err = source.Current(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "token2", token)
+
+	// The last token is still used when all the tokens have been rejected.
+	source.Reject("token2")
+	token, err = source.Current(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "token2", token)
+
+	_, err = NewTokenSource(NewMemoryTokenStore()).Current(context.Background())
+	assert.ErrorIs(t, err, ErrNoValidToken)
+
+	// The rejected tokens are forgotten when the store changes.
+	store.SetTokens("token3", "token1")
+	oauth2Token, err := source.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "token3", oauth2Token.AccessToken)
+}
+
+func TestTokenStoreHTTPClient_FallbackOnUnauthorized(t *testing.T) {
+	var seen []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = append(seen, r.Header.Get("Authorization"))
+
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, `{"name":"example.com"}`+"\n", string(body))
+
+		if r.Header.Get("Authorization") != "Bearer valid" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = io.WriteString(w, `{"message":"Authentication failed"}`)
+			return
+		}
+		httpResponse := httpResponseFixture(t, "/api/createDomain/created.http")
+		w.WriteHeader(httpResponse.StatusCode)
+		_, _ = io.Copy(w, httpResponse.Body)
+	}))
+	defer server.Close()
+
+	store := NewMemoryTokenStore("revoked", "valid")
+	c := NewClient(TokenStoreHTTPClient(store), WithBaseURL(server.URL))
+
+	_, err := c.Domains.CreateDomain(context.Background(), "1010", Domain{Name: "example.com"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Bearer revoked", "Bearer valid"}, seen)
+
+	// The rejected token is not used anymore.
+	seen = nil
+	_, err = c.Domains.CreateDomain(context.Background(), "1010", Domain{Name: "example.com"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Bearer valid"}, seen)
+}
+
+func TestNewClient_WithTokenStore_AllTokensRejected(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = io.WriteString(w, `{"message":"Authentication failed"}`)
+	}))
+	defer server.Close()
+
+	c := NewClient(nil, WithTokenStore(NewMemoryTokenStore("revoked1", "revoked2")), WithBaseURL(server.URL))
+
+	_, err := c.Identity.Whoami(context.Background())
+	assert.ErrorIs(t, err, ErrUnauthorized)
+
+	_, err = c.Identity.Whoami(context.Background())
+	assert.ErrorIs(t, err, ErrUnauthorized)
+}
+
+func TestNewClient_WithTokenStore_SingleTokenRecovers(t *testing.T) {
+	var seen []string
+	unauthorized := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = append(seen, r.Header.Get("Authorization"))
+		if unauthorized {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = io.WriteString(w, `{"message":"Authentication failed"}`)
+			return
+		}
+		httpResponse := httpResponseFixture(t, "/api/whoami/success.http")
+		w.WriteHeader(httpResponse.StatusCode)
+		_, _ = io.Copy(w, httpResponse.Body)
+	}))
+	defer server.Close()
+
+	c := NewClient(nil, WithTokenStore(NewMemoryTokenStore("token")), WithBaseURL(server.URL))
+
+	_, err := c.Identity.Whoami(context.Background())
+	assert.ErrorIs(t, err, ErrUnauthorized)
+	assert.Equal(t, []string{"Bearer token"}, seen)
+
+	// The rejection was transient: the token is tried again, and accepted.
+	unauthorized = false
+	_, err = c.Identity.Whoami(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Bearer token", "Bearer token"}, seen)
+}
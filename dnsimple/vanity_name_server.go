@@ -40,7 +40,7 @@ func (s *VanityNameServersService) EnableVanityNameServers(ctx context.Context,
 	path := versioned(vanityNameServerPath(accountID, domainIdentifier))
 	vanityNameServerResponse := &VanityNameServerResponse{}
 
-	resp, err := s.client.put(ctx, path, nil, vanityNameServerResponse)
+	resp, err := s.client.put(ctx, "VanityNameServers.EnableVanityNameServers", path, nil, vanityNameServerResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -56,7 +56,7 @@ func (s *VanityNameServersService) DisableVanityNameServers(ctx context.Context,
 	path := versioned(vanityNameServerPath(accountID, domainIdentifier))
 	vanityNameServerResponse := &VanityNameServerResponse{}
 
-	resp, err := s.client.delete(ctx, path, nil, nil)
+	resp, err := s.client.delete(ctx, "VanityNameServers.DisableVanityNameServers", path, nil, nil)
 	if err != nil {
 		return nil, err
 	}
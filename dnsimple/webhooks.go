@@ -3,6 +3,7 @@ package dnsimple
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 // WebhooksService handles communication with the webhook related
@@ -46,7 +47,7 @@ func (s *WebhooksService) ListWebhooks(ctx context.Context, accountID string, _
 	path := versioned(webhookPath(accountID, 0))
 	webhooksResponse := &WebhooksResponse{}
 
-	resp, err := s.client.get(ctx, path, webhooksResponse)
+	resp, err := s.client.get(ctx, "Webhooks.ListWebhooks", path, webhooksResponse)
 	if err != nil {
 		return webhooksResponse, err
 	}
@@ -55,6 +56,28 @@ func (s *WebhooksService) ListWebhooks(ctx context.Context, accountID string, _
 	return webhooksResponse, nil
 }
 
+// ListWebhooksIter returns an iterator over the webhooks for an account,
+// that fetches the pages lazily as the iteration progresses.
+//
+// See ListWebhooks for the available options, and All to collect the results.
+func (s *WebhooksService) ListWebhooksIter(ctx context.Context, accountID string, options *ListOptions) iter.Seq2[Webhook, error] {
+	opts := ListOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	return paginate(ctx, s.client, firstPage(&opts), func(ctx context.Context, page int) ([]Webhook, *Response, error) {
+		opts := opts
+		opts.Page = &page
+
+		resp, err := s.ListWebhooks(ctx, accountID, &opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Data, &resp.Response, nil
+	})
+}
+
 // CreateWebhook creates a new webhook.
 //
 // See https://developer.dnsimple.com/v2/webhooks/#createWebhook
@@ -62,7 +85,7 @@ func (s *WebhooksService) CreateWebhook(ctx context.Context, accountID string, w
 	path := versioned(webhookPath(accountID, 0))
 	webhookResponse := &WebhookResponse{}
 
-	resp, err := s.client.post(ctx, path, webhookAttributes, webhookResponse)
+	resp, err := s.client.post(ctx, "Webhooks.CreateWebhook", path, webhookAttributes, webhookResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -78,7 +101,7 @@ func (s *WebhooksService) GetWebhook(ctx context.Context, accountID string, webh
 	path := versioned(webhookPath(accountID, webhookID))
 	webhookResponse := &WebhookResponse{}
 
-	resp, err := s.client.get(ctx, path, webhookResponse)
+	resp, err := s.client.get(ctx, "Webhooks.GetWebhook", path, webhookResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -94,7 +117,7 @@ func (s *WebhooksService) DeleteWebhook(ctx context.Context, accountID string, w
 	path := versioned(webhookPath(accountID, webhookID))
 	webhookResponse := &WebhookResponse{}
 
-	resp, err := s.client.delete(ctx, path, nil, nil)
+	resp, err := s.client.delete(ctx, "Webhooks.DeleteWebhook", path, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -23,7 +23,7 @@ func (s *ZonesService) CheckZoneDistribution(ctx context.Context, accountID stri
 	path := versioned(fmt.Sprintf("/%v/zones/%v/distribution", accountID, zoneName))
 	zoneDistributionResponse := &ZoneDistributionResponse{}
 
-	resp, err := s.client.get(ctx, path, zoneDistributionResponse)
+	resp, err := s.client.get(ctx, "Zones.CheckZoneDistribution", path, zoneDistributionResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -39,7 +39,7 @@ func (s *ZonesService) CheckZoneRecordDistribution(ctx context.Context, accountI
 	path := versioned(fmt.Sprintf("/%v/zones/%v/records/%v/distribution", accountID, zoneName, recordID))
 	zoneDistributionResponse := &ZoneDistributionResponse{}
 
-	resp, err := s.client.get(ctx, path, zoneDistributionResponse)
+	resp, err := s.client.get(ctx, "Zones.CheckZoneRecordDistribution", path, zoneDistributionResponse)
 	if err != nil {
 		return nil, err
 	}
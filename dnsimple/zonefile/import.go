@@ -0,0 +1,210 @@
+package zonefile
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+)
+
+// ImportMode defines what happens to the records of the zone that are not in the zone file.
+type ImportMode int
+
+const (
+	// Merge keeps the records of the zone that are not in the zone file.
+	Merge ImportMode = iota
+	// Replace deletes the records of the zone that are not in the zone file,
+	// except the system records managed by DNSimple.
+	Replace
+)
+
+// ImportOptions specifies the options of an import.
+type ImportOptions struct {
+	// Mode is Merge by default.
+	Mode ImportMode
+
+	// DryRun returns the report of the changes without applying them.
+	DryRun bool
+}
+
+// ImportReport represents the changes of an import.
+type ImportReport struct {
+	// Creates are the records of the zone file that the zone doesn't have.
+	Creates []dnsimple.ZoneRecordAttributes
+
+	// Updates are the records of the zone whose TTL differs from the zone file, with the TTL of the zone file.
+	Updates []dnsimple.ZoneRecord
+
+	// Deletes are the records of the zone that are not in the zone file, in the Replace mode.
+	Deletes []dnsimple.ZoneRecord
+
+	// Unchanged are the records of the zone file that the zone already has.
+	Unchanged []dnsimple.ZoneRecord
+
+	// Skipped are the records of the zone file managed by DNSimple:
+	// the SOA record and the NS records of the apex.
+	Skipped []dnsimple.ZoneRecord
+
+	// Applied reports whether the changes were applied, that is false for a dry run.
+	Applied bool
+}
+
+// Empty reports whether the import has no changes.
+func (r *ImportReport) Empty() bool {
+	return len(r.Creates) == 0 && len(r.Updates) == 0 && len(r.Deletes) == 0
+}
+
+// Request returns the batch request that applies the changes.
+func (r *ImportReport) Request() dnsimple.BatchChangeZoneRecordsRequest {
+	var request dnsimple.BatchChangeZoneRecordsRequest
+	request.Creates = slices.Clone(r.Creates)
+	for _, record := range r.Updates {
+		request.Updates = append(request.Updates, dnsimple.ZoneRecordUpdateRequest{ID: record.ID, TTL: record.TTL})
+	}
+	for _, record := range r.Deletes {
+		request.Deletes = append(request.Deletes, dnsimple.ZoneRecordDeleteRequest{ID: record.ID})
+	}
+	return request
+}
+
+// String returns the changes as zone file lines prefixed with + for the creates,
+// ~ for the updates and - for the deletes, followed by a summary.
+func (r *ImportReport) String() string {
+	var sb strings.Builder
+	for _, attributes := range r.Creates {
+		fmt.Fprintf(&sb, "+ %s\n", formatRecord(attributesRecord(attributes)))
+	}
+	for _, record := range r.Updates {
+		fmt.Fprintf(&sb, "~ %s\n", formatRecord(record))
+	}
+	for _, record := range r.Deletes {
+		fmt.Fprintf(&sb, "- %s\n", formatRecord(record))
+	}
+
+	summary := "%d to create, %d to update, %d to delete, %d unchanged, %d skipped\n"
+	if r.Applied {
+		summary = "%d created, %d updated, %d deleted, %d unchanged, %d skipped\n"
+	}
+	fmt.Fprintf(&sb, summary, len(r.Creates), len(r.Updates), len(r.Deletes), len(r.Unchanged), len(r.Skipped))
+	return sb.String()
+}
+
+// Import parses the zone file and imports its records into the zone. See ImportRecords.
+func Import(ctx context.Context, zones dnsimple.ZonesAPI, accountID, zone string, r io.Reader, options *ImportOptions) (*ImportReport, error) {
+	records, err := Parse(r, zone)
+	if err != nil {
+		return nil, err
+	}
+	return ImportRecords(ctx, zones, accountID, zone, records, options)
+}
+
+// ImportRecords imports records, typically parsed from a zone file, into the zone.
+//
+// The SOA record and the NS records of the apex are skipped, as DNSimple manages them.
+// The records that the zone doesn't have are created, the records whose TTL differs are updated,
+// and in the Replace mode the other records of the zone, except its system records, are deleted.
+// The changes are applied at once with ZonesService.BatchChangeZoneRecords, so that either all
+// of them or none are applied, unless options.DryRun is set.
+func ImportRecords(ctx context.Context, zones dnsimple.ZonesAPI, accountID, zone string, records []dnsimple.ZoneRecord, options *ImportOptions) (*ImportReport, error) {
+	if options == nil {
+		options = &ImportOptions{}
+	}
+
+	var existing []dnsimple.ZoneRecord
+	for record, err := range zones.ListRecordsIter(ctx, accountID, trimDot(zone), nil) {
+		if err != nil {
+			return nil, err
+		}
+		existing = append(existing, record)
+	}
+
+	report := planImport(records, existing, options.Mode)
+	if options.DryRun || report.Empty() {
+		return report, nil
+	}
+
+	if _, err := zones.BatchChangeZoneRecords(ctx, accountID, trimDot(zone), report.Request()); err != nil {
+		return report, err
+	}
+	report.Applied = true
+	return report, nil
+}
+
+// planImport returns the changes to import the records into a zone with the existing records.
+func planImport(records, existing []dnsimple.ZoneRecord, mode ImportMode) *ImportReport {
+	report := &ImportReport{}
+
+	existingByKey := map[string]dnsimple.ZoneRecord{}
+	for _, record := range existing {
+		existingByKey[record.Key()] = record
+	}
+
+	imported := map[string]bool{}
+	for _, record := range records {
+		if isManaged(record) {
+			report.Skipped = append(report.Skipped, record)
+			continue
+		}
+		key := record.Key()
+		if imported[key] {
+			continue
+		}
+		imported[key] = true
+
+		current, ok := existingByKey[key]
+		switch {
+		case !ok:
+			report.Creates = append(report.Creates, recordAttributes(record))
+		case current.TTL != record.TTL && record.TTL != 0:
+			current.TTL = record.TTL
+			report.Updates = append(report.Updates, current)
+		default:
+			report.Unchanged = append(report.Unchanged, current)
+		}
+	}
+
+	if mode == Replace {
+		for _, record := range existing {
+			if !record.SystemRecord && !isManaged(record) && !imported[record.Key()] {
+				report.Deletes = append(report.Deletes, record)
+			}
+		}
+	}
+	return report
+}
+
+// isManaged reports whether DNSimple manages the record: the SOA record and the NS records of the apex.
+func isManaged(record dnsimple.ZoneRecord) bool {
+	recordType := strings.ToUpper(record.Type)
+	return recordType == "SOA" || recordType == "NS" && record.Name == ""
+}
+
+// recordAttributes returns the attributes to create the record.
+func recordAttributes(record dnsimple.ZoneRecord) dnsimple.ZoneRecordAttributes {
+	return dnsimple.ZoneRecordAttributes{
+		Type:     strings.ToUpper(record.Type),
+		Name:     dnsimple.String(record.Name),
+		Content:  record.Content,
+		TTL:      record.TTL,
+		Priority: record.Priority,
+		Regions:  record.Regions,
+	}
+}
+
+// attributesRecord returns the record with the attributes.
+func attributesRecord(attributes dnsimple.ZoneRecordAttributes) dnsimple.ZoneRecord {
+	record := dnsimple.ZoneRecord{
+		Type:     attributes.Type,
+		Content:  attributes.Content,
+		TTL:      attributes.TTL,
+		Priority: attributes.Priority,
+		Regions:  attributes.Regions,
+	}
+	if attributes.Name != nil {
+		record.Name = *attributes.Name
+	}
+	return record
+}
@@ -0,0 +1,127 @@
+package zonefile
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple/dnsimpletest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const importZone = `$ORIGIN example.com.
+$TTL 3600
+@	SOA	ns1.example.net. hostmaster 2024010101 86400 7200 2419200 300
+@	NS	ns1.example.net.
+@	NS	ns2.example.net.
+@	MX	10 mail
+www	300	A	192.0.2.1
+mail	A	192.0.2.2
+`
+
+// newImportServer returns a fake API with the zone example.com, and its www and old records.
+func newImportServer(t *testing.T) (*dnsimpletest.Server, *dnsimple.Client) {
+	server := dnsimpletest.NewServer()
+	t.Cleanup(server.Close)
+	client := server.Client()
+	ctx := context.Background()
+
+	_, err := client.Domains.CreateDomain(ctx, server.AccountID, dnsimple.Domain{Name: "example.com"})
+	require.NoError(t, err)
+	for _, attributes := range []dnsimple.ZoneRecordAttributes{
+		{Name: dnsimple.String("www"), Type: "A", Content: "192.0.2.1", TTL: 3600},
+		{Name: dnsimple.String("old"), Type: "A", Content: "192.0.2.9", TTL: 3600},
+	} {
+		_, err := client.Zones.CreateRecord(ctx, server.AccountID, "example.com", attributes)
+		require.NoError(t, err)
+	}
+	return server, client
+}
+
+// zoneRecords returns the records of the zone that are not system records, as zone file lines.
+func zoneRecords(t *testing.T, client *dnsimple.Client, accountID string) []string {
+	var lines []string
+	for record, err := range client.Zones.ListRecordsIter(context.Background(), accountID, "example.com", nil) {
+		require.NoError(t, err)
+		if !record.SystemRecord {
+			lines = append(lines, formatRecord(record))
+		}
+	}
+	return lines
+}
+
+func TestImport_DryRun(t *testing.T) {
+	server, client := newImportServer(t)
+
+	report, err := Import(context.Background(), client.Zones, server.AccountID, "example.com", strings.NewReader(importZone), &ImportOptions{DryRun: true, Mode: Replace})
+
+	require.NoError(t, err)
+	assert.False(t, report.Applied)
+	assert.Len(t, report.Skipped, 3)
+	assert.Equal(t, "+ @\t3600\tIN\tMX\t10 mail.example.com.\n"+
+		"+ mail\t3600\tIN\tA\t192.0.2.2\n"+
+		"~ www\t300\tIN\tA\t192.0.2.1\n"+
+		"- old\t3600\tIN\tA\t192.0.2.9\n"+
+		"2 to create, 1 to update, 1 to delete, 0 unchanged, 3 skipped\n", report.String())
+
+	// Nothing is applied.
+	assert.ElementsMatch(t, []string{"www\t3600\tIN\tA\t192.0.2.1", "old\t3600\tIN\tA\t192.0.2.9"}, zoneRecords(t, client, server.AccountID))
+}
+
+func TestImport_Merge(t *testing.T) {
+	server, client := newImportServer(t)
+
+	report, err := Import(context.Background(), client.Zones, server.AccountID, "example.com", strings.NewReader(importZone), nil)
+
+	require.NoError(t, err)
+	assert.True(t, report.Applied)
+	assert.Contains(t, report.String(), "\n2 created, 1 updated, 0 deleted, 0 unchanged, 3 skipped\n")
+	assert.ElementsMatch(t, []string{
+		"www\t300\tIN\tA\t192.0.2.1",
+		"old\t3600\tIN\tA\t192.0.2.9",
+		"@\t3600\tIN\tMX\t10 mail.example.com.",
+		"mail\t3600\tIN\tA\t192.0.2.2",
+	}, zoneRecords(t, client, server.AccountID))
+
+	// Importing again changes nothing.
+	report, err = Import(context.Background(), client.Zones, server.AccountID, "example.com", strings.NewReader(importZone), nil)
+
+	require.NoError(t, err)
+	assert.True(t, report.Empty())
+	assert.False(t, report.Applied)
+	assert.Len(t, report.Unchanged, 3)
+}
+
+func TestImport_Replace(t *testing.T) {
+	server, client := newImportServer(t)
+
+	report, err := Import(context.Background(), client.Zones, server.AccountID, "example.com", strings.NewReader(importZone), &ImportOptions{Mode: Replace})
+
+	require.NoError(t, err)
+	assert.True(t, report.Applied)
+	assert.ElementsMatch(t, []string{
+		"www\t300\tIN\tA\t192.0.2.1",
+		"@\t3600\tIN\tMX\t10 mail.example.com.",
+		"mail\t3600\tIN\tA\t192.0.2.2",
+	}, zoneRecords(t, client, server.AccountID))
+}
+
+func TestImport_Error(t *testing.T) {
+	server, client := newImportServer(t)
+
+	report, err := Import(context.Background(), client.Zones, server.AccountID, "example.com", strings.NewReader("new A 192.0.2.5\nnew BOGUS data\n"), nil)
+
+	var batchErr *dnsimple.BatchChangeError
+	require.ErrorAs(t, err, &batchErr)
+	require.Len(t, batchErr.Creates, 1)
+	assert.Equal(t, 1, batchErr.Creates[0].Index)
+	assert.False(t, report.Applied)
+	// The batch is atomic: the valid record isn't created either.
+	assert.ElementsMatch(t, []string{"www\t3600\tIN\tA\t192.0.2.1", "old\t3600\tIN\tA\t192.0.2.9"}, zoneRecords(t, client, server.AccountID))
+
+	_, err = Import(context.Background(), client.Zones, server.AccountID, "example.com", strings.NewReader("www.example.org. A 192.0.2.1\n"), nil)
+	var parseErr *ParseError
+	assert.ErrorAs(t, err, &parseErr)
+}
@@ -0,0 +1,440 @@
+package zonefile
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+)
+
+// token is a field of an entry of the zone file.
+type token struct {
+	// raw is the text of the field as written, with the quotes and the escapes.
+	raw string
+	// value is the text of the field, without the quotes and with the escapes resolved.
+	value string
+}
+
+// entry is a logical line of the zone file, that can span several lines within parentheses.
+type entry struct {
+	line int
+	// indented reports whether the entry starts with a blank, and so has no owner name.
+	indented bool
+	tokens   []token
+}
+
+// lex splits the zone file into entries, skipping the comments and the blank lines.
+func lex(data string) ([]entry, error) {
+	var entries []entry
+	var current entry
+	line, depth := 1, 0
+	startOfLine := true
+
+	flush := func() {
+		if len(current.tokens) > 0 {
+			entries = append(entries, current)
+		}
+		current = entry{}
+	}
+
+	for i := 0; i < len(data); {
+		c := data[i]
+
+		if startOfLine && depth == 0 {
+			current = entry{line: line, indented: c == ' ' || c == '\t'}
+		}
+		startOfLine = false
+
+		switch {
+		case c == '\n':
+			line++
+			i++
+			startOfLine = true
+			if depth == 0 {
+				flush()
+			}
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+		case c == ';':
+			for i < len(data) && data[i] != '\n' {
+				i++
+			}
+		case c == '(':
+			depth++
+			i++
+		case c == ')':
+			if depth == 0 {
+				return nil, &ParseError{Line: line, Err: errors.New("unbalanced parenthesis")}
+			}
+			depth--
+			i++
+		default:
+			tok, n, err := lexToken(data[i:])
+			if err != nil {
+				return nil, &ParseError{Line: line, Err: err}
+			}
+			line += strings.Count(tok.raw, "\n")
+			current.tokens = append(current.tokens, tok)
+			i += n
+		}
+	}
+
+	if depth > 0 {
+		return nil, &ParseError{Line: line, Err: errors.New("unclosed parenthesis")}
+	}
+	flush()
+	return entries, nil
+}
+
+// lexToken reads the token at the start of data, quoted or not, and returns it with its length.
+func lexToken(data string) (token, int, error) {
+	quoted := data[0] == '"'
+	var value strings.Builder
+	i := 0
+	if quoted {
+		i++
+	}
+
+	for i < len(data) {
+		c := data[i]
+		if quoted && c == '"' {
+			return token{raw: data[:i+1], value: value.String()}, i + 1, nil
+		}
+		if !quoted && strings.IndexByte(" \t\r\n;()\"", c) >= 0 {
+			break
+		}
+		if c == '\\' {
+			c, n, err := dnsimple.UnescapeRecordTextChar(data[i:])
+			if err != nil {
+				return token{}, 0, err
+			}
+			value.WriteByte(c)
+			i += n
+			continue
+		}
+		value.WriteByte(c)
+		i++
+	}
+
+	if quoted {
+		return token{}, 0, errors.New("unclosed quoted string")
+	}
+	return token{raw: data[:i], value: value.String()}, i, nil
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// parser holds the state of the zone file while it is parsed.
+type parser struct {
+	zone   string
+	origin string
+	// defaultTTL is the TTL of the $TTL directive, or of the previous record.
+	defaultTTL int
+	hasTTL     bool
+	owner      string
+	hasOwner   bool
+}
+
+// Parse parses the zone file of the zone, and returns its records.
+//
+// The names of the records are relative to zone, that is also the initial origin of the file.
+// When zone is empty, it's the name of the first $ORIGIN directive of the file.
+// The records are returned in the order of the file, with ZoneID set to the zone.
+//
+// Parse supports the $ORIGIN and $TTL directives, "@", relative names, omitted owner names,
+// TTLs with units (e.g. 1h30m), the IN class, multi-line entries within parentheses, comments,
+// and quoted and escaped strings. The character strings of the TXT and SPF records are concatenated.
+// The errors are *ParseError values, with the line of the error.
+func Parse(r io.Reader, zone string) ([]dnsimple.ZoneRecord, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := lex(string(data))
+	if err != nil {
+		return nil, err
+	}
+
+	zone = trimDot(zone)
+	p := &parser{zone: zone, origin: zone}
+	var records []dnsimple.ZoneRecord
+	for _, e := range entries {
+		record, ok, err := p.parseEntry(e)
+		if err != nil {
+			return nil, &ParseError{Line: e.line, Err: err}
+		}
+		if ok {
+			records = append(records, record)
+		}
+	}
+	return records, nil
+}
+
+// parseEntry parses a directive, or a record that it returns.
+func (p *parser) parseEntry(e entry) (dnsimple.ZoneRecord, bool, error) {
+	tokens := e.tokens
+	if !e.indented && strings.HasPrefix(tokens[0].raw, "$") {
+		return dnsimple.ZoneRecord{}, false, p.parseDirective(tokens)
+	}
+	if p.zone == "" {
+		return dnsimple.ZoneRecord{}, false, errors.New("record before $ORIGIN, with no zone")
+	}
+
+	if e.indented {
+		if !p.hasOwner {
+			return dnsimple.ZoneRecord{}, false, errors.New("missing owner name")
+		}
+	} else {
+		p.owner = p.absolute(tokens[0].raw)
+		p.hasOwner = true
+		tokens = tokens[1:]
+	}
+	name, err := p.relative(p.owner)
+	if err != nil {
+		return dnsimple.ZoneRecord{}, false, err
+	}
+
+	ttl, hasTTL := 0, false
+	for i := 0; i < 2 && len(tokens) > 0; i++ {
+		if isDigit(tokens[0].raw[0]) && !hasTTL {
+			ttl, err = parseTTL(tokens[0].raw)
+			if err != nil {
+				return dnsimple.ZoneRecord{}, false, err
+			}
+			hasTTL = true
+			tokens = tokens[1:]
+			continue
+		}
+		if class := strings.ToUpper(tokens[0].raw); class == "IN" || class == "CH" || class == "HS" || class == "CS" {
+			if class != "IN" {
+				return dnsimple.ZoneRecord{}, false, fmt.Errorf("unsupported class %s", class)
+			}
+			tokens = tokens[1:]
+		}
+	}
+	if len(tokens) == 0 {
+		return dnsimple.ZoneRecord{}, false, errors.New("missing record type")
+	}
+	if len(tokens) == 1 {
+		return dnsimple.ZoneRecord{}, false, fmt.Errorf("missing content of the %s record", tokens[0].raw)
+	}
+
+	if hasTTL {
+		if !p.hasTTL {
+			p.defaultTTL = ttl
+		}
+	} else {
+		ttl = p.defaultTTL
+	}
+
+	record := dnsimple.ZoneRecord{
+		ZoneID: p.zone,
+		Name:   name,
+		Type:   strings.ToUpper(tokens[0].raw),
+		TTL:    ttl,
+	}
+	if err := p.parseContent(&record, tokens[1:]); err != nil {
+		return dnsimple.ZoneRecord{}, false, err
+	}
+	return record, true, nil
+}
+
+// parseDirective parses a $ORIGIN or $TTL directive.
+func (p *parser) parseDirective(tokens []token) error {
+	directive := strings.ToUpper(tokens[0].raw)
+	switch directive {
+	case "$ORIGIN":
+		if len(tokens) != 2 {
+			return errors.New("$ORIGIN expects a name")
+		}
+		if p.origin == "" && !isAbsolute(tokens[1].raw) {
+			return errors.New("relative $ORIGIN, with no zone")
+		}
+		p.origin = p.absolute(tokens[1].raw)
+		if p.zone == "" {
+			p.zone = p.origin
+		}
+	case "$TTL":
+		if len(tokens) != 2 {
+			return errors.New("$TTL expects a TTL")
+		}
+		ttl, err := parseTTL(tokens[1].raw)
+		if err != nil {
+			return err
+		}
+		p.defaultTTL = ttl
+		p.hasTTL = true
+	default:
+		return fmt.Errorf("unsupported directive %s", tokens[0].raw)
+	}
+	return nil
+}
+
+// parseContent sets the content, and the priority, of the record from its fields.
+func (p *parser) parseContent(record *dnsimple.ZoneRecord, tokens []token) error {
+	fields := func(n int) error {
+		if len(tokens) != n {
+			return fmt.Errorf("%s record expects %d fields, got %d", record.Type, n, len(tokens))
+		}
+		return nil
+	}
+
+	switch {
+	case isTextType(record.Type):
+		var content strings.Builder
+		for _, tok := range tokens {
+			content.WriteString(tok.value)
+		}
+		record.Content = content.String()
+
+	case hostTypes[record.Type]:
+		if err := fields(1); err != nil {
+			return err
+		}
+		record.Content = p.target(tokens[0].raw)
+
+	case record.Type == "MX":
+		if err := fields(2); err != nil {
+			return err
+		}
+		priority, err := parseUint16(tokens[0].raw, "priority")
+		if err != nil {
+			return err
+		}
+		record.Priority = priority
+		record.Content = p.target(tokens[1].raw)
+
+	case record.Type == "SRV":
+		if err := fields(4); err != nil {
+			return err
+		}
+		priority, err := parseUint16(tokens[0].raw, "priority")
+		if err != nil {
+			return err
+		}
+		for _, tok := range tokens[1:3] {
+			if _, err := parseUint16(tok.raw, "weight and port"); err != nil {
+				return err
+			}
+		}
+		record.Priority = priority
+		record.Content = tokens[1].raw + " " + tokens[2].raw + " " + p.target(tokens[3].raw)
+
+	case record.Type == "SOA":
+		if err := fields(7); err != nil {
+			return err
+		}
+		// The serial is an unsigned 32-bit number, rather than a TTL.
+		serial, err := strconv.ParseUint(tokens[2].raw, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid SOA serial %q", tokens[2].raw)
+		}
+		content := []string{p.target(tokens[0].raw), p.target(tokens[1].raw), strconv.FormatUint(serial, 10)}
+		for _, tok := range tokens[3:] {
+			value, err := parseTTL(tok.raw)
+			if err != nil {
+				return err
+			}
+			content = append(content, strconv.Itoa(value))
+		}
+		record.Content = strings.Join(content, " ")
+
+	case record.Type == "NAPTR":
+		if err := fields(6); err != nil {
+			return err
+		}
+		record.Content = joinRaw(tokens[:5]) + " " + p.target(tokens[5].raw)
+
+	default:
+		record.Content = joinRaw(tokens)
+	}
+	return nil
+}
+
+// absolute returns the fully qualified name, without the trailing dot, of a name relative to the origin.
+func (p *parser) absolute(name string) string {
+	switch {
+	case name == "@":
+		return p.origin
+	case isAbsolute(name):
+		return trimDot(name)
+	case p.origin == "":
+		return name
+	default:
+		return name + "." + p.origin
+	}
+}
+
+// relative returns the name relative to the zone, "" for the apex.
+func (p *parser) relative(name string) (string, error) {
+	if strings.EqualFold(name, p.zone) {
+		return "", nil
+	}
+	suffix := "." + p.zone
+	if len(name) > len(suffix) && strings.EqualFold(name[len(name)-len(suffix):], suffix) {
+		return name[:len(name)-len(suffix)], nil
+	}
+	return "", fmt.Errorf("name %s is not in zone %s", name, p.zone)
+}
+
+// target returns the fully qualified host name of the content, without the trailing dot.
+func (p *parser) target(name string) string {
+	if name == "." {
+		return name
+	}
+	return p.absolute(name)
+}
+
+func joinRaw(tokens []token) string {
+	raw := make([]string, len(tokens))
+	for i, tok := range tokens {
+		raw[i] = tok.raw
+	}
+	return strings.Join(raw, " ")
+}
+
+func parseUint16(s, field string) (int, error) {
+	value, err := strconv.ParseUint(s, 10, 16)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q", field, s)
+	}
+	return int(value), nil
+}
+
+// ttlUnits are the units of the TTLs, in seconds.
+var ttlUnits = map[byte]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+
+// parseTTL parses a TTL in seconds, or with units as in 1h30m.
+func parseTTL(s string) (int, error) {
+	if value, err := strconv.ParseUint(s, 10, 31); err == nil {
+		return int(value), nil
+	}
+
+	ttl, number, digits := 0, 0, false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if isDigit(c) {
+			number = number*10 + int(c-'0')
+			digits = true
+			if number > 1<<31-1 {
+				return 0, fmt.Errorf("invalid TTL %q", s)
+			}
+			continue
+		}
+		unit, ok := ttlUnits[c|0x20]
+		if !ok || !digits {
+			return 0, fmt.Errorf("invalid TTL %q", s)
+		}
+		ttl += number * unit
+		number, digits = 0, false
+	}
+	if s == "" || digits || ttl > 1<<31-1 {
+		return 0, fmt.Errorf("invalid TTL %q", s)
+	}
+	return ttl, nil
+}
@@ -0,0 +1,140 @@
+package zonefile
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const bindZone = `; Zone of example.com
+$TTL 1h
+$ORIGIN example.com.
+@	IN	SOA	ns1.example.net. hostmaster (
+			2024010101 ; serial
+			1d         ; refresh
+			2h         ; retry
+			4w         ; expire
+			300 )      ; minimum
+	IN	NS	ns1.example.net.
+	IN	NS	ns2.example.net.
+@	300	IN	A	192.0.2.1
+	IN	AAAA	2001:db8::1
+	IN	MX	10 mail
+	IN	MX	20 mail.example.net.
+	IN	TXT	"v=spf1 mx -all"
+www	IN	300	CNAME	@
+mail	A	192.0.2.2
+Blog.Example.COM.	CNAME	hosting.example.net.
+_sip._tcp	SRV	10 60 5060 sip
+key._domainkey	TXT	( "v=DKIM1; k=rsa; "
+			  "p=MIGfMA0GCSqGSIb3DQEBAQUAA4GNADCBiQKBgQ" )
+note	TXT	"say \"hello\"" world ; not part of the content
+*	CAA	0 issue "letsencrypt.org"
+
+$ORIGIN sub.example.com.
+@	NS	ns1.sub.example.com.
+ns1	A	192.0.2.3
+`
+
+func TestParse(t *testing.T) {
+	records, err := Parse(strings.NewReader(bindZone), "example.com")
+
+	require.NoError(t, err)
+	assert.Equal(t, []dnsimple.ZoneRecord{
+		{ZoneID: "example.com", Name: "", Type: "SOA", TTL: 3600, Content: "ns1.example.net hostmaster.example.com 2024010101 86400 7200 2419200 300"},
+		{ZoneID: "example.com", Name: "", Type: "NS", TTL: 3600, Content: "ns1.example.net"},
+		{ZoneID: "example.com", Name: "", Type: "NS", TTL: 3600, Content: "ns2.example.net"},
+		{ZoneID: "example.com", Name: "", Type: "A", TTL: 300, Content: "192.0.2.1"},
+		{ZoneID: "example.com", Name: "", Type: "AAAA", TTL: 3600, Content: "2001:db8::1"},
+		{ZoneID: "example.com", Name: "", Type: "MX", TTL: 3600, Priority: 10, Content: "mail.example.com"},
+		{ZoneID: "example.com", Name: "", Type: "MX", TTL: 3600, Priority: 20, Content: "mail.example.net"},
+		{ZoneID: "example.com", Name: "", Type: "TXT", TTL: 3600, Content: "v=spf1 mx -all"},
+		{ZoneID: "example.com", Name: "www", Type: "CNAME", TTL: 300, Content: "example.com"},
+		{ZoneID: "example.com", Name: "mail", Type: "A", TTL: 3600, Content: "192.0.2.2"},
+		{ZoneID: "example.com", Name: "Blog", Type: "CNAME", TTL: 3600, Content: "hosting.example.net"},
+		{ZoneID: "example.com", Name: "_sip._tcp", Type: "SRV", TTL: 3600, Priority: 10, Content: "60 5060 sip.example.com"},
+		{ZoneID: "example.com", Name: "key._domainkey", Type: "TXT", TTL: 3600, Content: "v=DKIM1; k=rsa; p=MIGfMA0GCSqGSIb3DQEBAQUAA4GNADCBiQKBgQ"},
+		{ZoneID: "example.com", Name: "note", Type: "TXT", TTL: 3600, Content: `say "hello"world`},
+		{ZoneID: "example.com", Name: "*", Type: "CAA", TTL: 3600, Content: `0 issue "letsencrypt.org"`},
+		{ZoneID: "example.com", Name: "sub", Type: "NS", TTL: 3600, Content: "ns1.sub.example.com"},
+		{ZoneID: "example.com", Name: "ns1.sub", Type: "A", TTL: 3600, Content: "192.0.2.3"},
+	}, records)
+}
+
+func TestParse_ZoneFile(t *testing.T) {
+	zoneFile := "$ORIGIN example.com.\n$TTL 1h\nexample.com. 3600 IN SOA ns1.dnsimple.com. admin.dnsimple.com. 1453132552 86400 7200 604800 300\nexample.com. 3600 IN NS ns1.dnsimple.com.\n"
+
+	records, err := Parse(strings.NewReader(zoneFile), "")
+
+	require.NoError(t, err)
+	assert.Equal(t, []dnsimple.ZoneRecord{
+		{ZoneID: "example.com", Name: "", Type: "SOA", TTL: 3600, Content: "ns1.dnsimple.com admin.dnsimple.com 1453132552 86400 7200 604800 300"},
+		{ZoneID: "example.com", Name: "", Type: "NS", TTL: 3600, Content: "ns1.dnsimple.com"},
+	}, records)
+}
+
+func TestParse_SOASerial(t *testing.T) {
+	records, err := Parse(strings.NewReader("@ SOA ns1 admin 4294967295 1d 2h 4w 5m\n"), "example.com.")
+
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, "ns1.example.com admin.example.com 4294967295 86400 7200 2419200 300", records[0].Content)
+}
+
+func TestParse_PreviousTTL(t *testing.T) {
+	records, err := Parse(strings.NewReader("a 600 A 192.0.2.1\nb A 192.0.2.2\nc 60 A 192.0.2.3\n"), "example.com.")
+
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	assert.Equal(t, 600, records[1].TTL)
+	assert.Equal(t, 60, records[2].TTL)
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		zone string
+		data string
+		err  string
+	}{
+		{"out of zone", "example.com", "www.example.org. A 192.0.2.1", "zonefile: line 1: name www.example.org is not in zone example.com"},
+		{"no zone", "", "www A 192.0.2.1", "zonefile: line 1: record before $ORIGIN, with no zone"},
+		{"no owner", "example.com", "\n  A 192.0.2.1", "zonefile: line 2: missing owner name"},
+		{"no content", "example.com", "www 3600 IN A", "zonefile: line 1: missing content of the A record"},
+		{"class", "example.com", "www CH A 192.0.2.1", "zonefile: line 1: unsupported class CH"},
+		{"ttl", "example.com", "$TTL 1x", `zonefile: line 1: invalid TTL "1x"`},
+		{"include", "example.com", "$INCLUDE other.zone", "zonefile: line 1: unsupported directive $INCLUDE"},
+		{"mx", "example.com", "@ MX mail", "zonefile: line 1: MX record expects 2 fields, got 1"},
+		{"priority", "example.com", "@ MX 99999 mail", `zonefile: line 1: invalid priority "99999"`},
+		{"srv", "example.com", "_sip._tcp SRV 10 60 sip", "zonefile: line 1: SRV record expects 4 fields, got 3"},
+		{"quote", "example.com", "@ TXT \"unclosed\n", "zonefile: line 1: unclosed quoted string"},
+		{"serial", "example.com", "@ SOA ns1 admin 4294967296 1 2 3 4", `zonefile: line 1: invalid SOA serial "4294967296"`},
+		{"parenthesis", "example.com", "@ SOA ( ns1 admin 1 2 3 4 5\n", "zonefile: line 2: unclosed parenthesis"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.data), tt.zone)
+
+			var parseErr *ParseError
+			require.ErrorAs(t, err, &parseErr)
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestParseTTL(t *testing.T) {
+	for s, want := range map[string]int{"0": 0, "3600": 3600, "1h": 3600, "1H30M": 5400, "1w2d": 777600, "10s": 10} {
+		ttl, err := parseTTL(s)
+		require.NoError(t, err, s)
+		assert.Equal(t, want, ttl, s)
+	}
+
+	for _, s := range []string{"", "h", "1h1", "1y", "-1", "99999999999"} {
+		_, err := parseTTL(s)
+		assert.Error(t, err, s)
+	}
+}
@@ -0,0 +1,145 @@
+package zonefile
+
+import (
+	"bufio"
+	"cmp"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+)
+
+// Format returns the canonical zone file of the records of the zone. See Write.
+func Format(zone string, records []dnsimple.ZoneRecord) string {
+	var sb strings.Builder
+	_ = Write(&sb, zone, records)
+	return sb.String()
+}
+
+// Write writes the records of the zone to w as a canonical zone file.
+//
+// The file starts with the $ORIGIN of the zone, followed by one record per line:
+// the SOA record first, then the records sorted by name in the canonical DNS order, type,
+// priority and content. The names are relative to the zone, with "@" for the apex,
+// the host names in the content are fully qualified, and the content of the TXT and SPF records
+// is quoted and split in strings of at most 255 characters.
+// The same records always give the same file, regardless of their order.
+func Write(w io.Writer, zone string, records []dnsimple.ZoneRecord) error {
+	zone = trimDot(zone)
+	records = slices.Clone(records)
+	slices.SortStableFunc(records, compareRecords)
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "$ORIGIN %s\n", fqdn(zone))
+	for _, record := range records {
+		bw.WriteString(formatRecord(record))
+		bw.WriteByte('\n')
+	}
+	return bw.Flush()
+}
+
+// formatRecord returns the line of the record in a zone file, without the newline.
+func formatRecord(record dnsimple.ZoneRecord) string {
+	var sb strings.Builder
+	name := record.Name
+	if name == "" {
+		name = "@"
+	}
+	sb.WriteString(name)
+	if record.TTL > 0 {
+		sb.WriteString("\t" + strconv.Itoa(record.TTL))
+	}
+	fmt.Fprintf(&sb, "\tIN\t%s\t%s", strings.ToUpper(record.Type), formatContent(record))
+	return sb.String()
+}
+
+// formatContent returns the fields of the record as written in a zone file.
+func formatContent(record dnsimple.ZoneRecord) string {
+	recordType := strings.ToUpper(record.Type)
+	switch {
+	case isTextType(recordType):
+		return quoteText(record.Content)
+
+	case hostTypes[recordType]:
+		return fqdn(record.Content)
+
+	case recordType == "MX":
+		return strconv.Itoa(record.Priority) + " " + fqdn(record.Content)
+
+	case recordType == "SRV":
+		fields := strings.Fields(record.Content)
+		if len(fields) == 3 {
+			fields[2] = fqdn(fields[2])
+		}
+		return strconv.Itoa(record.Priority) + " " + strings.Join(fields, " ")
+
+	case recordType == "SOA":
+		fields := strings.Fields(record.Content)
+		for i := 0; i < 2 && i < len(fields); i++ {
+			fields[i] = fqdn(fields[i])
+		}
+		return strings.Join(fields, " ")
+
+	case recordType == "NAPTR":
+		fields := strings.Fields(record.Content)
+		if len(fields) > 0 {
+			fields[len(fields)-1] = fqdn(fields[len(fields)-1])
+		}
+		return strings.Join(fields, " ")
+
+	default:
+		return record.Content
+	}
+}
+
+// quoteText returns the text as quoted strings of at most 255 bytes. See dnsimple.QuoteRecordText.
+// A text that is already quoted, as returned by the API for some records, is returned unchanged.
+func quoteText(text string) string {
+	if strings.HasPrefix(text, `"`) {
+		return text
+	}
+	return dnsimple.QuoteRecordText(text)
+}
+
+// compareRecords orders the records of a zone file: the SOA record first,
+// then by name, type, priority and content.
+func compareRecords(a, b dnsimple.ZoneRecord) int {
+	aSOA, bSOA := strings.EqualFold(a.Type, "SOA"), strings.EqualFold(b.Type, "SOA")
+	if aSOA != bSOA {
+		if aSOA {
+			return -1
+		}
+		return 1
+	}
+	return cmp.Or(
+		compareNames(a.Name, b.Name),
+		cmp.Compare(strings.ToUpper(a.Type), strings.ToUpper(b.Type)),
+		cmp.Compare(a.Priority, b.Priority),
+		cmp.Compare(a.Content, b.Content),
+	)
+}
+
+// compareNames orders the names in the canonical DNS order of RFC 4034, section 6.1:
+// by their labels from the right, case-insensitively, so that the names of a subdomain are grouped.
+func compareNames(a, b string) int {
+	aLabels, bLabels := labels(a), labels(b)
+	for i := 0; i < len(aLabels) && i < len(bLabels); i++ {
+		if c := cmp.Compare(aLabels[i], bLabels[i]); c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(len(aLabels), len(bLabels))
+}
+
+// labels returns the lowercase labels of the name, from the right.
+func labels(name string) []string {
+	if name == "" {
+		return nil
+	}
+	labels := strings.Split(strings.ToLower(name), ".")
+	slices.Reverse(labels)
+	return labels
+}
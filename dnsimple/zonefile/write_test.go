@@ -0,0 +1,71 @@
+package zonefile
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dnsimple/dnsimple-go/v9/dnsimple"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormat(t *testing.T) {
+	records := []dnsimple.ZoneRecord{
+		{Name: "www", Type: "CNAME", TTL: 3600, Content: "example.com"},
+		{Name: "a.sub", Type: "A", TTL: 3600, Content: "192.0.2.3"},
+		{Name: "", Type: "MX", TTL: 3600, Priority: 20, Content: "mail.example.net"},
+		{Name: "", Type: "MX", TTL: 3600, Priority: 10, Content: "mail.example.com"},
+		{Name: "_sip._tcp", Type: "SRV", TTL: 600, Priority: 10, Content: "60 5060 sip.example.com"},
+		{Name: "", Type: "NS", TTL: 3600, Content: "ns1.dnsimple.com"},
+		{Name: "", Type: "SOA", TTL: 3600, Content: "ns1.dnsimple.com admin.dnsimple.com 1453132552 86400 7200 604800 300"},
+		{Name: "sub", Type: "A", Content: "192.0.2.2"},
+		{Name: "note", Type: "txt", TTL: 3600, Content: `say "hello" \ bye`},
+		{Name: "", Type: "CAA", TTL: 3600, Content: `0 issue "letsencrypt.org"`},
+	}
+
+	assert.Equal(t, `$ORIGIN example.com.
+@	3600	IN	SOA	ns1.dnsimple.com. admin.dnsimple.com. 1453132552 86400 7200 604800 300
+@	3600	IN	CAA	0 issue "letsencrypt.org"
+@	3600	IN	MX	10 mail.example.com.
+@	3600	IN	MX	20 mail.example.net.
+@	3600	IN	NS	ns1.dnsimple.com.
+_sip._tcp	600	IN	SRV	10 60 5060 sip.example.com.
+note	3600	IN	TXT	"say \"hello\" \\ bye"
+sub	IN	A	192.0.2.2
+a.sub	3600	IN	A	192.0.2.3
+www	3600	IN	CNAME	example.com.
+`, Format("example.com", records))
+}
+
+func TestFormat_LongText(t *testing.T) {
+	text := strings.Repeat("a", 300)
+	records := []dnsimple.ZoneRecord{{Name: "dkim", Type: "TXT", TTL: 3600, Content: text}}
+
+	zoneFile := Format("example.com.", records)
+
+	assert.Equal(t, "$ORIGIN example.com.\ndkim\t3600\tIN\tTXT\t\""+strings.Repeat("a", 255)+"\" \""+strings.Repeat("a", 45)+"\"\n", zoneFile)
+}
+
+func TestFormat_EscapedText(t *testing.T) {
+	records := []dnsimple.ZoneRecord{{Name: "note", Type: "TXT", TTL: 3600, Content: "café\tbar"}}
+
+	zoneFile := Format("example.com.", records)
+
+	assert.Equal(t, "$ORIGIN example.com.\nnote\t3600\tIN\tTXT\t\"café\\009bar\"\n", zoneFile)
+
+	parsed, err := Parse(strings.NewReader(zoneFile), "")
+	require.NoError(t, err)
+	assert.Equal(t, "café\tbar", parsed[0].Content)
+}
+
+func TestFormat_RoundTrip(t *testing.T) {
+	records, err := Parse(strings.NewReader(bindZone), "example.com")
+	require.NoError(t, err)
+
+	zoneFile := Format("example.com", records)
+	parsed, err := Parse(strings.NewReader(zoneFile), "example.com")
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, records, parsed)
+	assert.Equal(t, zoneFile, Format("example.com", parsed))
+}
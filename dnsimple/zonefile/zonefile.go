@@ -0,0 +1,84 @@
+// Package zonefile parses and writes zone files in the master file format of RFC 1035,
+// the format of BIND and of ZonesService.GetZoneFile, to and from DNSimple zone records.
+//
+//	zoneFileResponse, err := client.Zones.GetZoneFile(ctx, accountID, "example.com")
+//	if err != nil {
+//		return err
+//	}
+//	records, err := zonefile.Parse(strings.NewReader(zoneFileResponse.Data.Zone), "example.com")
+//
+// The records follow the conventions of the DNSimple API:
+// the names are relative to the zone, with "" for the apex,
+// the host names in the content are fully qualified without the trailing dot,
+// the MX and SRV priorities are in ZoneRecord.Priority, and the content of the SRV records
+// is "weight port target".
+//
+// Format and Write write the records back as a canonical zone file: sorted, one record per line,
+// with the host names fully qualified, so that a zone can be versioned and diffed.
+package zonefile
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseError is the error returned by Parse for an invalid zone file.
+type ParseError struct {
+	// Line is the line of the zone file where the error is, starting at 1.
+	Line int
+	Err  error
+}
+
+// Error implements the error interface.
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("zonefile: line %d: %v", e.Line, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// hostTypes are the types whose content is a host name.
+var hostTypes = map[string]bool{
+	"ALIAS": true,
+	"CNAME": true,
+	"DNAME": true,
+	"NS":    true,
+	"POOL":  true,
+	"PTR":   true,
+}
+
+// isTextType reports whether the content of the type is a sequence of character strings.
+func isTextType(recordType string) bool {
+	return recordType == "TXT" || recordType == "SPF"
+}
+
+// trimDot returns the name without the trailing dot of a fully qualified name.
+// The root name "." is returned unchanged.
+func trimDot(name string) string {
+	if name == "." || !isAbsolute(name) {
+		return name
+	}
+	return name[:len(name)-1]
+}
+
+// isAbsolute reports whether the name ends with an unescaped dot.
+func isAbsolute(name string) bool {
+	if !strings.HasSuffix(name, ".") {
+		return false
+	}
+	backslashes := 0
+	for i := len(name) - 2; i >= 0 && name[i] == '\\'; i-- {
+		backslashes++
+	}
+	return backslashes%2 == 0
+}
+
+// fqdn returns the fully qualified name, with the trailing dot.
+func fqdn(name string) string {
+	if name == "" || isAbsolute(name) {
+		return name
+	}
+	return name + "."
+}
@@ -3,6 +3,7 @@ package dnsimple
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 // ZonesService handles communication with the zone related
@@ -70,7 +71,7 @@ func (s *ZonesService) ListZones(ctx context.Context, accountID string, options
 		return nil, err
 	}
 
-	resp, err := s.client.get(ctx, path, zonesResponse)
+	resp, err := s.client.get(ctx, "Zones.ListZones", path, zonesResponse)
 	if err != nil {
 		return zonesResponse, err
 	}
@@ -79,6 +80,28 @@ func (s *ZonesService) ListZones(ctx context.Context, accountID string, options
 	return zonesResponse, nil
 }
 
+// ListZonesIter returns an iterator over the zones for an account,
+// that fetches the pages lazily as the iteration progresses.
+//
+// See ListZones for the available options, and All to collect the results.
+func (s *ZonesService) ListZonesIter(ctx context.Context, accountID string, options *ZoneListOptions) iter.Seq2[Zone, error] {
+	opts := ZoneListOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	return paginate(ctx, s.client, firstPage(&opts.ListOptions), func(ctx context.Context, page int) ([]Zone, *Response, error) {
+		opts := opts
+		opts.Page = &page
+
+		resp, err := s.ListZones(ctx, accountID, &opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Data, &resp.Response, nil
+	})
+}
+
 // GetZone fetches a zone.
 //
 // See https://developer.dnsimple.com/v2/zones/#getZone
@@ -86,7 +109,7 @@ func (s *ZonesService) GetZone(ctx context.Context, accountID string, zoneName s
 	path := versioned(fmt.Sprintf("/%v/zones/%v", accountID, zoneName))
 	zoneResponse := &ZoneResponse{}
 
-	resp, err := s.client.get(ctx, path, zoneResponse)
+	resp, err := s.client.get(ctx, "Zones.GetZone", path, zoneResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -102,7 +125,7 @@ func (s *ZonesService) GetZoneFile(ctx context.Context, accountID string, zoneNa
 	path := versioned(fmt.Sprintf("/%v/zones/%v/file", accountID, zoneName))
 	zoneFileResponse := &ZoneFileResponse{}
 
-	resp, err := s.client.get(ctx, path, zoneFileResponse)
+	resp, err := s.client.get(ctx, "Zones.GetZoneFile", path, zoneFileResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -118,7 +141,7 @@ func (s *ZonesService) ActivateZoneDns(ctx context.Context, accountID string, zo
 	path := versioned(fmt.Sprintf("/%v/zones/%v/activation", accountID, zoneName))
 	zoneResponse := &ZoneResponse{}
 
-	resp, err := s.client.put(ctx, path, nil, zoneResponse)
+	resp, err := s.client.put(ctx, "Zones.ActivateZoneDns", path, nil, zoneResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -134,7 +157,7 @@ func (s *ZonesService) DeactivateZoneDns(ctx context.Context, accountID string,
 	path := versioned(fmt.Sprintf("/%v/zones/%v/activation", accountID, zoneName))
 	zoneResponse := &ZoneResponse{}
 
-	resp, err := s.client.delete(ctx, path, nil, zoneResponse)
+	resp, err := s.client.delete(ctx, "Zones.DeactivateZoneDns", path, nil, zoneResponse)
 	if err != nil {
 		return nil, err
 	}
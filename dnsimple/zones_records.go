@@ -2,7 +2,10 @@ package dnsimple
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"iter"
 )
 
 // ZoneRecord represents a zone record in DNSimple.
@@ -84,7 +87,7 @@ func (s *ZonesService) ListRecords(ctx context.Context, accountID string, zoneNa
 		return nil, err
 	}
 
-	resp, err := s.client.get(ctx, path, recordsResponse)
+	resp, err := s.client.get(ctx, "Zones.ListRecords", path, recordsResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -93,6 +96,28 @@ func (s *ZonesService) ListRecords(ctx context.Context, accountID string, zoneNa
 	return recordsResponse, nil
 }
 
+// ListRecordsIter returns an iterator over the zone records for a zone,
+// that fetches the pages lazily as the iteration progresses.
+//
+// See ListRecords for the available options, and All to collect the results.
+func (s *ZonesService) ListRecordsIter(ctx context.Context, accountID string, zoneName string, options *ZoneRecordListOptions) iter.Seq2[ZoneRecord, error] {
+	opts := ZoneRecordListOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	return paginate(ctx, s.client, firstPage(&opts.ListOptions), func(ctx context.Context, page int) ([]ZoneRecord, *Response, error) {
+		opts := opts
+		opts.Page = &page
+
+		resp, err := s.ListRecords(ctx, accountID, zoneName, &opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Data, &resp.Response, nil
+	})
+}
+
 // CreateRecord creates a zone record.
 //
 // See https://developer.dnsimple.com/v2/zones/records/#createZoneRecord
@@ -100,7 +125,7 @@ func (s *ZonesService) CreateRecord(ctx context.Context, accountID string, zoneN
 	path := versioned(zoneRecordPath(accountID, zoneName, 0))
 	recordResponse := &ZoneRecordResponse{}
 
-	resp, err := s.client.post(ctx, path, recordAttributes, recordResponse)
+	resp, err := s.client.post(ctx, "Zones.CreateRecord", path, recordAttributes, recordResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -116,7 +141,7 @@ func (s *ZonesService) GetRecord(ctx context.Context, accountID string, zoneName
 	path := versioned(zoneRecordPath(accountID, zoneName, recordID))
 	recordResponse := &ZoneRecordResponse{}
 
-	resp, err := s.client.get(ctx, path, recordResponse)
+	resp, err := s.client.get(ctx, "Zones.GetRecord", path, recordResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -131,7 +156,7 @@ func (s *ZonesService) GetRecord(ctx context.Context, accountID string, zoneName
 func (s *ZonesService) UpdateRecord(ctx context.Context, accountID string, zoneName string, recordID int64, recordAttributes ZoneRecordAttributes) (*ZoneRecordResponse, error) {
 	path := versioned(zoneRecordPath(accountID, zoneName, recordID))
 	recordResponse := &ZoneRecordResponse{}
-	resp, err := s.client.patch(ctx, path, recordAttributes, recordResponse)
+	resp, err := s.client.patch(ctx, "Zones.UpdateRecord", path, recordAttributes, recordResponse)
 	if err != nil {
 		return nil, err
 	}
@@ -147,7 +172,7 @@ func (s *ZonesService) DeleteRecord(ctx context.Context, accountID string, zoneN
 	path := versioned(zoneRecordPath(accountID, zoneName, recordID))
 	recordResponse := &ZoneRecordResponse{}
 
-	resp, err := s.client.delete(ctx, path, nil, nil)
+	resp, err := s.client.delete(ctx, "Zones.DeleteRecord", path, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -164,13 +189,16 @@ type BatchChangeZoneRecordsRequest struct {
 }
 
 // ZoneRecordUpdateRequest represents an update request for a zone record in a batch operation.
+//
+// The attributes that are not set are left unchanged. Priority is a pointer,
+// so that the priority of a record can be updated to 0.
 type ZoneRecordUpdateRequest struct {
 	ID       int64    `json:"id"`
 	Type     string   `json:"type,omitempty"`
 	Name     *string  `json:"name,omitempty"`
 	Content  string   `json:"content,omitempty"`
 	TTL      int      `json:"ttl,omitempty"`
-	Priority int      `json:"priority,omitempty"`
+	Priority *int     `json:"priority,omitempty"`
 	Regions  []string `json:"regions,omitempty"`
 }
 
@@ -204,11 +232,120 @@ func (s *ZonesService) BatchChangeZoneRecords(ctx context.Context, accountID str
 	path := versioned(fmt.Sprintf("/%v/zones/%v/batch", accountID, zoneName))
 	batchResponse := &BatchChangeZoneRecordsResponse{}
 
-	resp, err := s.client.post(ctx, path, request, batchResponse)
+	resp, err := s.client.post(ctx, "Zones.BatchChangeZoneRecords", path, request, batchResponse)
 	if err != nil {
-		return nil, err
+		return nil, newBatchChangeError(err, &request)
 	}
 
 	batchResponse.HTTPResponse = resp
 	return batchResponse, nil
 }
+
+// BatchChangeError represents a failed BatchChangeZoneRecords call,
+// with the failures reported for each operation of the batch.
+//
+// It wraps the ErrorResponse, so the error can still be inspected with errors.As(err, &errorResponse).
+type BatchChangeError struct {
+	*ErrorResponse
+
+	Creates []BatchCreateError
+	Updates []BatchUpdateError
+	Deletes []BatchDeleteError
+}
+
+// BatchCreateError represents a failed create operation in a batch.
+type BatchCreateError struct {
+	// Index of the operation in BatchChangeZoneRecordsRequest.Creates.
+	Index int
+	// Record points to the operation in the original request, or nil if the index is out of range.
+	Record  *ZoneRecordAttributes
+	Message string
+	Errors  map[string][]string
+}
+
+// BatchUpdateError represents a failed update operation in a batch.
+type BatchUpdateError struct {
+	// Index of the operation in BatchChangeZoneRecordsRequest.Updates.
+	Index int
+	// Record points to the operation in the original request, or nil if the index is out of range.
+	Record  *ZoneRecordUpdateRequest
+	Message string
+	Errors  map[string][]string
+}
+
+// BatchDeleteError represents a failed delete operation in a batch.
+type BatchDeleteError struct {
+	// Index of the operation in BatchChangeZoneRecordsRequest.Deletes.
+	Index int
+	// Record points to the operation in the original request, or nil if the index is out of range.
+	Record  *ZoneRecordDeleteRequest
+	Message string
+	Errors  map[string][]string
+}
+
+// Unwrap returns the underlying ErrorResponse.
+func (e *BatchChangeError) Unwrap() error {
+	return e.ErrorResponse
+}
+
+type batchOperationError struct {
+	Index   int                 `json:"index"`
+	Message string              `json:"message"`
+	Errors  map[string][]string `json:"errors,omitempty"`
+}
+
+type batchChangeZoneRecordsErrors struct {
+	Creates []batchOperationError `json:"creates,omitempty"`
+	Updates []batchOperationError `json:"updates,omitempty"`
+	Deletes []batchOperationError `json:"deletes,omitempty"`
+}
+
+type batchChangeZoneRecordsErrorResponse struct {
+	Message string                       `json:"message"`
+	Errors  batchChangeZoneRecordsErrors `json:"errors"`
+}
+
+// newBatchChangeError returns a BatchChangeError if err is an ErrorResponse
+// that reports failures for the operations of the batch, or err otherwise.
+func newBatchChangeError(err error, request *BatchChangeZoneRecordsRequest) error {
+	var errorResponse *ErrorResponse
+	if !errors.As(err, &errorResponse) {
+		return err
+	}
+
+	var batchError batchChangeZoneRecordsErrorResponse
+	if json.Unmarshal(errorResponse.Body, &batchError) != nil {
+		return err
+	}
+
+	errs := batchError.Errors
+	if len(errs.Creates) == 0 && len(errs.Updates) == 0 && len(errs.Deletes) == 0 {
+		return err
+	}
+
+	batchChangeError := &BatchChangeError{ErrorResponse: errorResponse}
+	for _, e := range errs.Creates {
+		batchChangeError.Creates = append(batchChangeError.Creates, BatchCreateError{
+			Index: e.Index, Record: elementAt(request.Creates, e.Index), Message: e.Message, Errors: e.Errors,
+		})
+	}
+	for _, e := range errs.Updates {
+		batchChangeError.Updates = append(batchChangeError.Updates, BatchUpdateError{
+			Index: e.Index, Record: elementAt(request.Updates, e.Index), Message: e.Message, Errors: e.Errors,
+		})
+	}
+	for _, e := range errs.Deletes {
+		batchChangeError.Deletes = append(batchChangeError.Deletes, BatchDeleteError{
+			Index: e.Index, Record: elementAt(request.Deletes, e.Index), Message: e.Message, Errors: e.Errors,
+		})
+	}
+	return batchChangeError
+}
+
+// elementAt returns a pointer to the element at index i, or nil if i is out of range.
+func elementAt[T any](s []T, i int) *T {
+	if i < 0 || i >= len(s) {
+		return nil
+	}
+	return &s[i]
+}
@@ -0,0 +1,725 @@
+package dnsimple
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	// ErrInvalidRecordContent is wrapped by the errors of the record contents that are not valid.
+	ErrInvalidRecordContent = errors.New("dnsimple: invalid record content")
+
+	// ErrUnsupportedRecordType is wrapped by the errors of ParseRecordContent for the types without a typed content.
+	ErrUnsupportedRecordType = errors.New("dnsimple: unsupported record type")
+)
+
+// RecordContent represents the typed content of a zone record, such as MXContent or SRVContent.
+//
+// The typed contents are parsed from the records with ParseRecordContent or ZoneRecord.ParseContent,
+// and turned into records with NewZoneRecordAttributes:
+//
+//	attributes, err := dnsimple.NewZoneRecordAttributes("", dnsimple.MXContent{Priority: 10, Exchange: "mx.example.com"})
+type RecordContent interface {
+	// RecordType returns the type of the record, e.g. "MX".
+	RecordType() string
+
+	// Format returns the content of the record, and its priority for the MX and SRV records, as in ZoneRecord.
+	Format() (content string, priority int)
+
+	// Validate checks the content, and returns an error wrapping ErrInvalidRecordContent if it is not valid.
+	Validate() error
+}
+
+// ParseRecordContent parses the content and the priority of a record of the type into its typed content.
+//
+// The priority is only used by the MX and SRV records. The errors wrap ErrUnsupportedRecordType for the types
+// without a typed content, and ErrInvalidRecordContent for the contents that are not valid.
+func ParseRecordContent(recordType, content string, priority int) (RecordContent, error) {
+	recordType = strings.ToUpper(recordType)
+	content = strings.TrimSpace(content)
+
+	var parsed RecordContent
+	switch recordType {
+	case "A":
+		addr, err := netip.ParseAddr(content)
+		if err != nil {
+			return nil, contentError(recordType, "invalid address %q", content)
+		}
+		parsed = AContent{Address: addr}
+	case "AAAA":
+		addr, err := netip.ParseAddr(content)
+		if err != nil {
+			return nil, contentError(recordType, "invalid address %q", content)
+		}
+		parsed = AAAAContent{Address: addr}
+	case "CNAME":
+		parsed = CNAMEContent{Target: content}
+	case "ALIAS":
+		parsed = ALIASContent{Target: content}
+	case "NS":
+		parsed = NSContent{Host: content}
+	case "PTR":
+		parsed = PTRContent{Host: content}
+	case "POOL":
+		parsed = POOLContent{Target: content}
+	case "URL":
+		parsed = URLContent{URL: content}
+	case "TXT":
+		parsed = TXTContent{Text: UnquoteRecordText(content)}
+	case "SPF":
+		parsed = SPFContent{Text: UnquoteRecordText(content)}
+	case "MX":
+		parsed = MXContent{Priority: priority, Exchange: content}
+	case "SRV":
+		var c SRVContent
+		fields, err := contentFields(recordType, content, 3)
+		if err != nil {
+			return nil, err
+		}
+		c.Priority = priority
+		if c.Weight, err = parseContentInt(recordType, "weight", fields[0]); err != nil {
+			return nil, err
+		}
+		if c.Port, err = parseContentInt(recordType, "port", fields[1]); err != nil {
+			return nil, err
+		}
+		c.Target = fields[2]
+		parsed = c
+	case "CAA":
+		var c CAAContent
+		fields, err := contentFields(recordType, content, 3)
+		if err != nil {
+			return nil, err
+		}
+		if c.Flags, err = parseContentInt(recordType, "flags", fields[0]); err != nil {
+			return nil, err
+		}
+		c.Tag, c.Value = fields[1], fields[2]
+		parsed = c
+	case "SSHFP":
+		var c SSHFPContent
+		fields, err := contentFields(recordType, content, 3)
+		if err != nil {
+			return nil, err
+		}
+		if c.Algorithm, err = parseContentInt(recordType, "algorithm", fields[0]); err != nil {
+			return nil, err
+		}
+		if c.FingerprintType, err = parseContentInt(recordType, "fingerprint type", fields[1]); err != nil {
+			return nil, err
+		}
+		c.Fingerprint = fields[2]
+		parsed = c
+	case "TLSA":
+		var c TLSAContent
+		fields, err := contentFields(recordType, content, 4)
+		if err != nil {
+			return nil, err
+		}
+		if c.Usage, err = parseContentInt(recordType, "usage", fields[0]); err != nil {
+			return nil, err
+		}
+		if c.Selector, err = parseContentInt(recordType, "selector", fields[1]); err != nil {
+			return nil, err
+		}
+		if c.MatchingType, err = parseContentInt(recordType, "matching type", fields[2]); err != nil {
+			return nil, err
+		}
+		c.CertificateData = fields[3]
+		parsed = c
+	case "NAPTR":
+		var c NAPTRContent
+		fields, err := contentFields(recordType, content, 6)
+		if err != nil {
+			return nil, err
+		}
+		if c.Order, err = parseContentInt(recordType, "order", fields[0]); err != nil {
+			return nil, err
+		}
+		if c.Preference, err = parseContentInt(recordType, "preference", fields[1]); err != nil {
+			return nil, err
+		}
+		c.Flags, c.Service, c.Regexp, c.Replacement = fields[2], fields[3], fields[4], fields[5]
+		parsed = c
+	default:
+		return nil, fmt.Errorf("%w %q", ErrUnsupportedRecordType, recordType)
+	}
+
+	if err := parsed.Validate(); err != nil {
+		return nil, err
+	}
+	return parsed, nil
+}
+
+// ParseContent parses the content and the priority of the record into its typed content. See ParseRecordContent.
+func (r ZoneRecord) ParseContent() (RecordContent, error) {
+	return ParseRecordContent(r.Type, r.Content, r.Priority)
+}
+
+// NewZoneRecordAttributes validates the content, and returns the attributes of a record with the name,
+// "" for the apex, and the type, content and priority of the typed content.
+func NewZoneRecordAttributes(name string, content RecordContent) (ZoneRecordAttributes, error) {
+	if err := content.Validate(); err != nil {
+		return ZoneRecordAttributes{}, err
+	}
+	formatted, priority := content.Format()
+	return ZoneRecordAttributes{
+		Type:     content.RecordType(),
+		Name:     String(name),
+		Content:  formatted,
+		Priority: priority,
+	}, nil
+}
+
+// AContent represents the content of an A record.
+type AContent struct {
+	Address netip.Addr
+}
+
+// RecordType returns "A".
+func (c AContent) RecordType() string { return "A" }
+
+// Format returns the address.
+func (c AContent) Format() (string, int) { return c.Address.String(), 0 }
+
+// Validate checks that the address is an IPv4 address.
+func (c AContent) Validate() error {
+	if !c.Address.Is4() {
+		return contentError("A", "invalid IPv4 address %q", c.Address)
+	}
+	return nil
+}
+
+// AAAAContent represents the content of an AAAA record.
+type AAAAContent struct {
+	Address netip.Addr
+}
+
+// RecordType returns "AAAA".
+func (c AAAAContent) RecordType() string { return "AAAA" }
+
+// Format returns the address in its shortest form, e.g. 2001:db8::1.
+func (c AAAAContent) Format() (string, int) { return c.Address.String(), 0 }
+
+// Validate checks that the address is an IPv6 address.
+func (c AAAAContent) Validate() error {
+	if !c.Address.Is6() {
+		return contentError("AAAA", "invalid IPv6 address %q", c.Address)
+	}
+	return nil
+}
+
+// CNAMEContent represents the content of a CNAME record.
+type CNAMEContent struct {
+	Target string
+}
+
+// RecordType returns "CNAME".
+func (c CNAMEContent) RecordType() string { return "CNAME" }
+
+// Format returns the target.
+func (c CNAMEContent) Format() (string, int) { return c.Target, 0 }
+
+// Validate checks that the target is a domain name.
+func (c CNAMEContent) Validate() error { return validateContentHost("CNAME", "target", c.Target) }
+
+// ALIASContent represents the content of an ALIAS record.
+type ALIASContent struct {
+	Target string
+}
+
+// RecordType returns "ALIAS".
+func (c ALIASContent) RecordType() string { return "ALIAS" }
+
+// Format returns the target.
+func (c ALIASContent) Format() (string, int) { return c.Target, 0 }
+
+// Validate checks that the target is a domain name.
+func (c ALIASContent) Validate() error { return validateContentHost("ALIAS", "target", c.Target) }
+
+// NSContent represents the content of an NS record.
+type NSContent struct {
+	Host string
+}
+
+// RecordType returns "NS".
+func (c NSContent) RecordType() string { return "NS" }
+
+// Format returns the host.
+func (c NSContent) Format() (string, int) { return c.Host, 0 }
+
+// Validate checks that the host is a domain name.
+func (c NSContent) Validate() error { return validateContentHost("NS", "host", c.Host) }
+
+// PTRContent represents the content of a PTR record.
+type PTRContent struct {
+	Host string
+}
+
+// RecordType returns "PTR".
+func (c PTRContent) RecordType() string { return "PTR" }
+
+// Format returns the host.
+func (c PTRContent) Format() (string, int) { return c.Host, 0 }
+
+// Validate checks that the host is a domain name.
+func (c PTRContent) Validate() error { return validateContentHost("PTR", "host", c.Host) }
+
+// POOLContent represents the content of a POOL record, one of the hosts of the pool.
+type POOLContent struct {
+	Target string
+}
+
+// RecordType returns "POOL".
+func (c POOLContent) RecordType() string { return "POOL" }
+
+// Format returns the target.
+func (c POOLContent) Format() (string, int) { return c.Target, 0 }
+
+// Validate checks that the target is a domain name.
+func (c POOLContent) Validate() error { return validateContentHost("POOL", "target", c.Target) }
+
+// URLContent represents the content of a URL record, the URL to redirect to.
+type URLContent struct {
+	URL string
+}
+
+// RecordType returns "URL".
+func (c URLContent) RecordType() string { return "URL" }
+
+// Format returns the URL.
+func (c URLContent) Format() (string, int) { return c.URL, 0 }
+
+// Validate checks that the URL is an http or https URL with a host. The URLs without a scheme are http URLs.
+func (c URLContent) Validate() error {
+	raw := c.URL
+	if !strings.Contains(raw, "://") {
+		// The API redirects to http:// when the URL has no scheme.
+		raw = "http://" + raw
+	}
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" || strings.ContainsAny(c.URL, " \t") {
+		return contentError("URL", "invalid URL %q", c.URL)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return contentError("URL", "unsupported scheme %q", u.Scheme)
+	}
+	return nil
+}
+
+// TXTContent represents the content of a TXT record. Text is the text without the quotes,
+// with the strings of the record joined.
+type TXTContent struct {
+	Text string
+}
+
+// RecordType returns "TXT".
+func (c TXTContent) RecordType() string { return "TXT" }
+
+// Format returns the text quoted, split in strings of 255 bytes. See QuoteRecordText.
+func (c TXTContent) Format() (string, int) { return QuoteRecordText(c.Text), 0 }
+
+// Validate checks that the text is not empty, and is valid UTF-8.
+func (c TXTContent) Validate() error { return validateContentText("TXT", c.Text) }
+
+// SPFContent represents the content of an SPF record. Text is the text without the quotes,
+// with the strings of the record joined.
+type SPFContent struct {
+	Text string
+}
+
+// RecordType returns "SPF".
+func (c SPFContent) RecordType() string { return "SPF" }
+
+// Format returns the text quoted, split in strings of 255 bytes. See QuoteRecordText.
+func (c SPFContent) Format() (string, int) { return QuoteRecordText(c.Text), 0 }
+
+// Validate checks that the text is not empty, and is valid UTF-8.
+func (c SPFContent) Validate() error { return validateContentText("SPF", c.Text) }
+
+// MXContent represents the content and the priority of an MX record.
+type MXContent struct {
+	Priority int
+	Exchange string
+}
+
+// RecordType returns "MX".
+func (c MXContent) RecordType() string { return "MX" }
+
+// Format returns the exchange and the priority.
+func (c MXContent) Format() (string, int) { return c.Exchange, c.Priority }
+
+// Validate checks that the priority is in the range 0-65535, and that the exchange is a domain name.
+func (c MXContent) Validate() error {
+	if err := validateContentInt("MX", "priority", c.Priority, 65535); err != nil {
+		return err
+	}
+	return validateContentHost("MX", "exchange", c.Exchange)
+}
+
+// SRVContent represents the content and the priority of an SRV record.
+// The content of the record is the weight, the port and the target, e.g. "5 5060 sip.example.com".
+type SRVContent struct {
+	Priority int
+	Weight   int
+	Port     int
+	Target   string
+}
+
+// RecordType returns "SRV".
+func (c SRVContent) RecordType() string { return "SRV" }
+
+// Format returns the weight, the port and the target, and the priority.
+func (c SRVContent) Format() (string, int) {
+	return fmt.Sprintf("%d %d %s", c.Weight, c.Port, c.Target), c.Priority
+}
+
+// Validate checks that the priority, the weight and the port are in the range 0-65535,
+// and that the target is a domain name.
+func (c SRVContent) Validate() error {
+	if err := validateContentInt("SRV", "priority", c.Priority, 65535); err != nil {
+		return err
+	}
+	if err := validateContentInt("SRV", "weight", c.Weight, 65535); err != nil {
+		return err
+	}
+	if err := validateContentInt("SRV", "port", c.Port, 65535); err != nil {
+		return err
+	}
+	return validateContentHost("SRV", "target", c.Target)
+}
+
+// CAAContent represents the content of a CAA record, e.g. `0 issue "letsencrypt.org"`.
+// Value is the value without the quotes.
+type CAAContent struct {
+	Flags int
+	Tag   string
+	Value string
+}
+
+// RecordType returns "CAA".
+func (c CAAContent) RecordType() string { return "CAA" }
+
+// Format returns the flags, the tag and the value quoted.
+func (c CAAContent) Format() (string, int) {
+	return fmt.Sprintf("%d %s %s", c.Flags, c.Tag, quoteContentString(c.Value)), 0
+}
+
+// Validate checks that the flags are in the range 0-255, and that the tag is alphanumeric.
+func (c CAAContent) Validate() error {
+	if err := validateContentInt("CAA", "flags", c.Flags, 255); err != nil {
+		return err
+	}
+	if c.Tag == "" || strings.IndexFunc(c.Tag, func(r rune) bool { return !isAlphanumeric(r) }) >= 0 {
+		return contentError("CAA", "invalid tag %q", c.Tag)
+	}
+	return nil
+}
+
+// SSHFPContent represents the content of an SSHFP record, e.g. "4 2 123456789abcdef...".
+// Fingerprint is hexadecimal.
+type SSHFPContent struct {
+	Algorithm       int
+	FingerprintType int
+	Fingerprint     string
+}
+
+// RecordType returns "SSHFP".
+func (c SSHFPContent) RecordType() string { return "SSHFP" }
+
+// Format returns the algorithm, the fingerprint type and the fingerprint.
+func (c SSHFPContent) Format() (string, int) {
+	return fmt.Sprintf("%d %d %s", c.Algorithm, c.FingerprintType, c.Fingerprint), 0
+}
+
+// Validate checks that the algorithm and the fingerprint type are in the range 0-255,
+// and that the fingerprint is hexadecimal.
+func (c SSHFPContent) Validate() error {
+	if err := validateContentInt("SSHFP", "algorithm", c.Algorithm, 255); err != nil {
+		return err
+	}
+	if err := validateContentInt("SSHFP", "fingerprint type", c.FingerprintType, 255); err != nil {
+		return err
+	}
+	return validateContentHex("SSHFP", "fingerprint", c.Fingerprint)
+}
+
+// TLSAContent represents the content of a TLSA record, e.g. "3 1 1 0123456789abcdef...".
+// CertificateData is hexadecimal.
+type TLSAContent struct {
+	Usage           int
+	Selector        int
+	MatchingType    int
+	CertificateData string
+}
+
+// RecordType returns "TLSA".
+func (c TLSAContent) RecordType() string { return "TLSA" }
+
+// Format returns the usage, the selector, the matching type and the certificate data.
+func (c TLSAContent) Format() (string, int) {
+	return fmt.Sprintf("%d %d %d %s", c.Usage, c.Selector, c.MatchingType, c.CertificateData), 0
+}
+
+// Validate checks that the usage, the selector and the matching type are in the range 0-255,
+// and that the certificate data is hexadecimal.
+func (c TLSAContent) Validate() error {
+	if err := validateContentInt("TLSA", "usage", c.Usage, 255); err != nil {
+		return err
+	}
+	if err := validateContentInt("TLSA", "selector", c.Selector, 255); err != nil {
+		return err
+	}
+	if err := validateContentInt("TLSA", "matching type", c.MatchingType, 255); err != nil {
+		return err
+	}
+	return validateContentHex("TLSA", "certificate data", c.CertificateData)
+}
+
+// NAPTRContent represents the content of a NAPTR record,
+// e.g. `100 10 "U" "E2U+sip" "!^.*$!sip:info@example.com!" .`.
+// Flags, Service and Regexp are the strings without the quotes.
+type NAPTRContent struct {
+	Order       int
+	Preference  int
+	Flags       string
+	Service     string
+	Regexp      string
+	Replacement string
+}
+
+// RecordType returns "NAPTR".
+func (c NAPTRContent) RecordType() string { return "NAPTR" }
+
+// Format returns the order, the preference, the flags, the service and the regexp quoted, and the replacement.
+func (c NAPTRContent) Format() (string, int) {
+	return fmt.Sprintf("%d %d %s %s %s %s", c.Order, c.Preference,
+		quoteContentString(c.Flags), quoteContentString(c.Service), quoteContentString(c.Regexp), c.Replacement), 0
+}
+
+// Validate checks that the order and the preference are in the range 0-65535,
+// and that the replacement is a domain name.
+func (c NAPTRContent) Validate() error {
+	if err := validateContentInt("NAPTR", "order", c.Order, 65535); err != nil {
+		return err
+	}
+	if err := validateContentInt("NAPTR", "preference", c.Preference, 65535); err != nil {
+		return err
+	}
+	return validateContentHost("NAPTR", "replacement", c.Replacement)
+}
+
+func contentError(recordType, format string, args ...any) error {
+	return fmt.Errorf("%w: %s record: %s", ErrInvalidRecordContent, recordType, fmt.Sprintf(format, args...))
+}
+
+// contentFields splits the content in n fields separated by blanks, where the quoted strings are a single field
+// without the quotes.
+func contentFields(recordType, content string, n int) ([]string, error) {
+	fields := make([]string, 0, n)
+	for i := 0; i < len(content); {
+		switch c := content[i]; {
+		case c == ' ' || c == '\t':
+			i++
+		case c == '"':
+			var field strings.Builder
+			n, err := unquoteString(content[i:], &field)
+			if err != nil {
+				return nil, contentError(recordType, "%v in %q", err, content)
+			}
+			i += n
+			fields = append(fields, field.String())
+		default:
+			start := i
+			for i < len(content) && content[i] != ' ' && content[i] != '\t' {
+				i++
+			}
+			fields = append(fields, content[start:i])
+		}
+	}
+	if len(fields) != n {
+		return nil, contentError(recordType, "expected %d fields in %q", n, content)
+	}
+	return fields, nil
+}
+
+func parseContentInt(recordType, field, s string) (int, error) {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, contentError(recordType, "invalid %s %q", field, s)
+	}
+	return n, nil
+}
+
+func validateContentInt(recordType, field string, n, maxValue int) error {
+	if n < 0 || n > maxValue {
+		return contentError(recordType, "%s %d out of range 0-%d", field, n, maxValue)
+	}
+	return nil
+}
+
+// validateContentHost checks that the host is a domain name, or the root ".".
+func validateContentHost(recordType, field, host string) error {
+	if host == "" {
+		return contentError(recordType, "missing %s", field)
+	}
+	if host == "." {
+		return nil
+	}
+	name := strings.TrimSuffix(host, ".")
+	if len(name) > 253 {
+		return contentError(recordType, "%s %q too long", field, host)
+	}
+	for _, label := range strings.Split(name, ".") {
+		if label == "" || len(label) > 63 || strings.ContainsAny(label, " \t\"\\") {
+			return contentError(recordType, "invalid %s %q", field, host)
+		}
+	}
+	return nil
+}
+
+func validateContentHex(recordType, field, s string) error {
+	if _, err := hex.DecodeString(s); err != nil || s == "" {
+		return contentError(recordType, "invalid hexadecimal %s %q", field, s)
+	}
+	return nil
+}
+
+// validateContentText checks the text of the TXT and SPF records.
+func validateContentText(recordType, text string) error {
+	if text == "" {
+		return contentError(recordType, "missing text")
+	}
+	if !utf8.ValidString(text) {
+		return contentError(recordType, "invalid UTF-8 text %q", text)
+	}
+	return nil
+}
+
+func isAlphanumeric(r rune) bool {
+	return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9'
+}
+
+// maxTextStringLength is the maximum length of a string of the text of TXT and SPF records.
+const maxTextStringLength = 255
+
+// QuoteRecordText returns the content of a TXT or SPF record with the text: the text quoted,
+// in strings of at most 255 bytes separated by a space, that don't split the UTF-8 characters.
+// The quotes and the backslashes are escaped with a backslash, and the control characters and the invalid
+// UTF-8 bytes as \DDD, as in the zone files.
+func QuoteRecordText(text string) string {
+	if len(text) <= maxTextStringLength {
+		return quoteContentString(text)
+	}
+	chunks := make([]string, 0, len(text)/maxTextStringLength+1)
+	for len(text) > maxTextStringLength {
+		n := maxTextStringLength
+		for n > maxTextStringLength-utf8.UTFMax && !utf8.RuneStart(text[n]) {
+			n--
+		}
+		chunks = append(chunks, quoteContentString(text[:n]))
+		text = text[n:]
+	}
+	return strings.Join(append(chunks, quoteContentString(text)), " ")
+}
+
+// UnquoteRecordText returns the text of the content of a TXT or SPF record: its quoted strings
+// joined, with the escapes resolved, so that "v=spf1 " "-all" is v=spf1 -all. See QuoteRecordText.
+// A content that is not a sequence of quoted strings is returned unchanged.
+func UnquoteRecordText(content string) string {
+	if !strings.HasPrefix(content, `"`) {
+		return content
+	}
+
+	var text strings.Builder
+	for i := 0; i < len(content); {
+		switch content[i] {
+		case ' ', '\t':
+			i++
+		case '"':
+			n, err := unquoteString(content[i:], &text)
+			if err != nil {
+				// Unterminated string or invalid escape: the content is not a sequence of quoted strings.
+				return content
+			}
+			i += n
+		default:
+			return content
+		}
+	}
+	return text.String()
+}
+
+// quoteContentString quotes the string, escaping the quotes and the backslashes,
+// and the control characters and the invalid UTF-8 bytes as \DDD.
+func quoteContentString(s string) string {
+	var sb strings.Builder
+	sb.Grow(len(s) + 2)
+	sb.WriteByte('"')
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case r == '"' || r == '\\':
+			sb.WriteByte('\\')
+			sb.WriteByte(s[i])
+		case r < ' ' || r == 0x7f || r == utf8.RuneError && size == 1:
+			fmt.Fprintf(&sb, "\\%03d", s[i])
+		default:
+			sb.WriteString(s[i : i+size])
+		}
+		i += size
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
+
+// unquoteString writes the quoted string at the start of s, with its escapes resolved,
+// and returns its length with the quotes. It returns an error if the string is unterminated
+// or has an invalid escape.
+func unquoteString(s string, sb *strings.Builder) (int, error) {
+	for i := 1; i < len(s); {
+		switch s[i] {
+		case '"':
+			return i + 1, nil
+		case '\\':
+			c, n, err := UnescapeRecordTextChar(s[i:])
+			if err != nil {
+				return 0, err
+			}
+			sb.WriteByte(c)
+			i += n
+		default:
+			sb.WriteByte(s[i])
+			i++
+		}
+	}
+	return 0, errors.New("unterminated string")
+}
+
+// UnescapeRecordTextChar decodes the escape at the start of s, as in the texts of the record contents
+// and in the zone files: a backslash followed by a character, or by the 3 digits of the decimal value of a byte.
+// It returns the escaped byte and the length of the escape, or an error if the escape is incomplete
+// or its decimal value is above 255.
+func UnescapeRecordTextChar(s string) (byte, int, error) {
+	if len(s) < 2 || s[0] != '\\' {
+		return 0, 0, fmt.Errorf("invalid escape %q", s)
+	}
+	if len(s) >= 4 && isDecimal(s[1:4]) {
+		code, _ := strconv.Atoi(s[1:4])
+		if code > 255 {
+			return 0, 0, fmt.Errorf("invalid escape %q", s[:4])
+		}
+		return byte(code), 4, nil
+	}
+	return s[1], 2, nil
+}
+
+func isDecimal(s string) bool {
+	return strings.IndexFunc(s, func(r rune) bool { return r < '0' || r > '9' }) < 0
+}
@@ -0,0 +1,210 @@
+package dnsimple
+
+import (
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRecordContent(t *testing.T) {
+	tests := []struct {
+		recordType string
+		content    string
+		priority   int
+		want       RecordContent
+		formatted  string
+	}{
+		{"A", "192.0.2.1", 0, AContent{Address: netip.MustParseAddr("192.0.2.1")}, ""},
+		{"AAAA", "2001:0db8:0000:0000:0000:0000:0000:0001", 0, AAAAContent{Address: netip.MustParseAddr("2001:db8::1")}, "2001:db8::1"},
+		{"CNAME", "example.com.", 0, CNAMEContent{Target: "example.com."}, ""},
+		{"ALIAS", "example.herokuapp.com", 0, ALIASContent{Target: "example.herokuapp.com"}, ""},
+		{"NS", "ns1.dnsimple.com", 0, NSContent{Host: "ns1.dnsimple.com"}, ""},
+		{"PTR", "www.example.com", 0, PTRContent{Host: "www.example.com"}, ""},
+		{"POOL", "a.example.com", 0, POOLContent{Target: "a.example.com"}, ""},
+		{"URL", "https://example.com/path", 0, URLContent{URL: "https://example.com/path"}, ""},
+		{"URL", "example.com", 0, URLContent{URL: "example.com"}, ""},
+		{"TXT", `"v=spf1 " "-all"`, 0, TXTContent{Text: "v=spf1 -all"}, `"v=spf1 -all"`},
+		{"TXT", `say "hi"`, 0, TXTContent{Text: `say "hi"`}, `"say \"hi\""`},
+		{"SPF", "v=spf1 -all", 0, SPFContent{Text: "v=spf1 -all"}, `"v=spf1 -all"`},
+		{"mx", "mx.example.com", 10, MXContent{Priority: 10, Exchange: "mx.example.com"}, ""},
+		{"SRV", "5 5060 sip.example.com", 10, SRVContent{Priority: 10, Weight: 5, Port: 5060, Target: "sip.example.com"}, ""},
+		{"CAA", `0 issue "letsencrypt.org"`, 0, CAAContent{Flags: 0, Tag: "issue", Value: "letsencrypt.org"}, ""},
+		{"CAA", `128 iodef "mailto:security@example.com"`, 0, CAAContent{Flags: 128, Tag: "iodef", Value: "mailto:security@example.com"}, ""},
+		{"SSHFP", "4 2 0123456789abcdef", 0, SSHFPContent{Algorithm: 4, FingerprintType: 2, Fingerprint: "0123456789abcdef"}, ""},
+		{"TLSA", "3 1 1 0123456789ABCDEF", 0, TLSAContent{Usage: 3, Selector: 1, MatchingType: 1, CertificateData: "0123456789ABCDEF"}, ""},
+		{"NAPTR", `100 10 "U" "E2U+sip" "!^.*$!sip:info@example.com!" .`, 0,
+			NAPTRContent{Order: 100, Preference: 10, Flags: "U", Service: "E2U+sip", Regexp: "!^.*$!sip:info@example.com!", Replacement: "."}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.recordType+" "+tt.content, func(t *testing.T) {
+			content, err := ParseRecordContent(tt.recordType, tt.content, tt.priority)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, content)
+			assert.Equal(t, strings.ToUpper(tt.recordType), content.RecordType())
+
+			formatted, priority := content.Format()
+			if tt.formatted == "" {
+				tt.formatted = tt.content
+			}
+			assert.Equal(t, tt.formatted, formatted)
+			assert.Equal(t, tt.priority, priority)
+		})
+	}
+}
+
+func TestParseRecordContent_Errors(t *testing.T) {
+	tests := []struct {
+		recordType string
+		content    string
+		priority   int
+		err        string
+	}{
+		{"A", "2001:db8::1", 0, `A record: invalid IPv4 address "2001:db8::1"`},
+		{"A", "192.0.2", 0, `A record: invalid address "192.0.2"`},
+		{"AAAA", "192.0.2.1", 0, `AAAA record: invalid IPv6 address "192.0.2.1"`},
+		{"CNAME", "", 0, "CNAME record: missing target"},
+		{"CNAME", "www..example.com", 0, `CNAME record: invalid target "www..example.com"`},
+		{"NS", strings.Repeat("a", 64) + ".example.com", 0, "NS record: invalid host"},
+		{"URL", "ftp://example.com", 0, `URL record: unsupported scheme "ftp"`},
+		{"URL", "https://", 0, `URL record: invalid URL "https://"`},
+		{"MX", "mx.example.com", 70000, "MX record: priority 70000 out of range 0-65535"},
+		{"SRV", "5 sip.example.com", 10, `SRV record: expected 3 fields in "5 sip.example.com"`},
+		{"SRV", "5 sip 5060", 10, `SRV record: invalid port "sip"`},
+		{"CAA", `0 issue letsencrypt.org extra`, 0, "CAA record: expected 3 fields"},
+		{"CAA", `0 issue "letsencrypt.org`, 0, "CAA record: unterminated string"},
+		{"CAA", `256 issue "letsencrypt.org"`, 0, "CAA record: flags 256 out of range 0-255"},
+		{"CAA", `0 is-sue "letsencrypt.org"`, 0, `CAA record: invalid tag "is-sue"`},
+		{"SSHFP", "4 2 xyz", 0, `SSHFP record: invalid hexadecimal fingerprint "xyz"`},
+		{"TLSA", "3 1 1", 0, "TLSA record: expected 4 fields"},
+		{"NAPTR", `100 10 "U" "E2U+sip" "" ""`, 0, "NAPTR record: missing replacement"},
+		{"TXT", `""`, 0, "TXT record: missing text"},
+		{"TXT", `"caf\233"`, 0, `TXT record: invalid UTF-8 text "caf\xe9"`},
+		{"SPF", "", 0, "SPF record: missing text"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.recordType+" "+tt.content, func(t *testing.T) {
+			_, err := ParseRecordContent(tt.recordType, tt.content, tt.priority)
+
+			assert.ErrorIs(t, err, ErrInvalidRecordContent)
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestParseRecordContent_UnsupportedType(t *testing.T) {
+	_, err := ParseRecordContent("HINFO", "x86 linux", 0)
+
+	assert.ErrorIs(t, err, ErrUnsupportedRecordType)
+	assert.EqualError(t, err, `dnsimple: unsupported record type "HINFO"`)
+}
+
+func TestZoneRecord_ParseContent(t *testing.T) {
+	record := ZoneRecord{Type: "MX", Content: "mx.example.com", Priority: 10}
+
+	content, err := record.ParseContent()
+
+	require.NoError(t, err)
+	assert.Equal(t, MXContent{Priority: 10, Exchange: "mx.example.com"}, content)
+}
+
+func TestNewZoneRecordAttributes(t *testing.T) {
+	attributes, err := NewZoneRecordAttributes("_sip._tcp", SRVContent{Priority: 10, Weight: 5, Port: 5060, Target: "sip.example.com"})
+
+	require.NoError(t, err)
+	assert.Equal(t, ZoneRecordAttributes{
+		Type:     "SRV",
+		Name:     String("_sip._tcp"),
+		Content:  "5 5060 sip.example.com",
+		Priority: 10,
+	}, attributes)
+
+	_, err = NewZoneRecordAttributes("www", AContent{})
+
+	assert.ErrorIs(t, err, ErrInvalidRecordContent)
+}
+
+func TestTXTContent_Format_LongText(t *testing.T) {
+	text := strings.Repeat("a", 300)
+
+	formatted, _ := TXTContent{Text: text}.Format()
+
+	assert.Equal(t, `"`+strings.Repeat("a", 255)+`" "`+strings.Repeat("a", 45)+`"`, formatted)
+
+	content, err := ParseRecordContent("TXT", formatted, 0)
+	require.NoError(t, err)
+	assert.Equal(t, TXTContent{Text: text}, content)
+}
+
+func TestQuoteRecordText(t *testing.T) {
+	tests := []struct {
+		text, content string
+	}{
+		{"v=spf1 -all", `"v=spf1 -all"`},
+		{`say "hi" \ bye`, `"say \"hi\" \\ bye"`},
+		{"café", `"café"`},
+		{"tab\there", `"tab\009here"`},
+		{"caf\xe9", `"caf\233"`},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.content, QuoteRecordText(tt.text), tt.text)
+		assert.Equal(t, tt.text, UnquoteRecordText(tt.content), tt.content)
+	}
+}
+
+func TestQuoteRecordText_Split(t *testing.T) {
+	text := strings.Repeat("a", 300)
+	assert.Equal(t, `"`+text[:255]+`" "`+text[255:]+`"`, QuoteRecordText(text))
+	assert.Equal(t, text, UnquoteRecordText(QuoteRecordText(text)))
+
+	// The 2 bytes of é are at 254 and 255: the first string ends before it.
+	text = strings.Repeat("a", 254) + "été"
+	assert.Equal(t, `"`+strings.Repeat("a", 254)+`" "été"`, QuoteRecordText(text))
+	assert.Equal(t, text, UnquoteRecordText(QuoteRecordText(text)))
+
+	// The 4 bytes of the emoji are at 253 to 256.
+	text = strings.Repeat("a", 253) + "🙂 ok"
+	assert.Equal(t, `"`+strings.Repeat("a", 253)+`" "🙂 ok"`, QuoteRecordText(text))
+	assert.Equal(t, text, UnquoteRecordText(QuoteRecordText(text)))
+}
+
+func TestUnquoteRecordText(t *testing.T) {
+	assert.Equal(t, "v=spf1 -all", UnquoteRecordText(`"v=spf1 " "-all"`))
+	assert.Equal(t, "v=spf1 -all", UnquoteRecordText("v=spf1 -all"))
+	assert.Equal(t, `"unterminated`, UnquoteRecordText(`"unterminated`))
+	assert.Equal(t, `"a" b`, UnquoteRecordText(`"a" b`))
+	assert.Equal(t, `"\999"`, UnquoteRecordText(`"\999"`))
+}
+
+func TestUnescapeRecordTextChar(t *testing.T) {
+	tests := []struct {
+		s string
+		c byte
+		n int
+	}{
+		{`\"`, '"', 2},
+		{`\\`, '\\', 2},
+		{`\a`, 'a', 2},
+		{`\009bar`, '\t', 4},
+		{`\255`, 255, 4},
+		{`\12"`, '1', 2},
+	}
+
+	for _, tt := range tests {
+		c, n, err := UnescapeRecordTextChar(tt.s)
+		assert.NoError(t, err, tt.s)
+		assert.Equal(t, tt.c, c, tt.s)
+		assert.Equal(t, tt.n, n, tt.s)
+	}
+
+	for _, s := range []string{`\`, `\256`, `\999`, "a"} {
+		_, _, err := UnescapeRecordTextChar(s)
+		assert.Error(t, err, s)
+	}
+}
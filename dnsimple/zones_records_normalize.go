@@ -0,0 +1,214 @@
+package dnsimple
+
+import (
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	// defaultRecordTTL is the TTL of the records created without TTL.
+	defaultRecordTTL = 3600
+
+	// defaultRecordRegion is the region of the records created without regions.
+	defaultRecordRegion = "global"
+)
+
+// hostRecordTypes are the record types whose content is a host name.
+var hostRecordTypes = map[string]bool{
+	"ALIAS": true,
+	"CNAME": true,
+	"DNAME": true,
+	"MX":    true,
+	"NS":    true,
+	"POOL":  true,
+	"PTR":   true,
+}
+
+// NormalizeRecordName returns the canonical form of the name of a record of the zone:
+// lowercase and relative to the zone, with "" for the apex.
+//
+// The fully qualified names, with a trailing dot, are made relative, and "@" is the apex.
+// The names without a trailing dot are relative already, even if they end with the zone:
+// www.example.com is www.example.com.example.com, distinct from www. The fully qualified names
+// outside the zone keep their trailing dot. When zone is empty, the name is only lowercased
+// and stripped of its trailing dot.
+func NormalizeRecordName(name, zone string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	zone = strings.ToLower(strings.TrimSuffix(zone, "."))
+	if name == "@" {
+		return ""
+	}
+	if zone == "" {
+		return strings.TrimSuffix(name, ".")
+	}
+	if !strings.HasSuffix(name, ".") {
+		return name
+	}
+	if name == zone+"." {
+		return ""
+	}
+	if relative, ok := strings.CutSuffix(name, "."+zone+"."); ok {
+		return relative
+	}
+	return name
+}
+
+// NormalizeRecordContent returns the canonical form of the content of a record of the type:
+//
+//   - the IPv4 and IPv6 addresses of A and AAAA records in their shortest form, e.g. 2001:db8::1
+//   - the host names, such as the targets of CNAME, MX and SRV records, lowercase without the trailing dot
+//   - the text of TXT and SPF records without the quotes, and with the strings joined,
+//     so that "v=spf1 " "-all" is v=spf1 -all
+//   - the value of CAA records quoted, and their tag lowercase
+//
+// The other contents have their blanks collapsed.
+func NormalizeRecordContent(recordType, content string) string {
+	recordType = strings.ToUpper(recordType)
+	content = strings.TrimSpace(content)
+
+	switch {
+	case recordType == "A" || recordType == "AAAA":
+		if addr, err := netip.ParseAddr(content); err == nil {
+			return addr.String()
+		}
+		return content
+
+	case recordType == "TXT" || recordType == "SPF":
+		return UnquoteRecordText(content)
+
+	case hostRecordTypes[recordType]:
+		return normalizeHost(content)
+
+	case recordType == "SRV":
+		fields := strings.Fields(content)
+		if len(fields) > 0 {
+			fields[len(fields)-1] = normalizeHost(fields[len(fields)-1])
+		}
+		return strings.Join(fields, " ")
+
+	case recordType == "CAA":
+		flags, rest, _ := strings.Cut(content, " ")
+		tag, value, ok := strings.Cut(strings.TrimSpace(rest), " ")
+		if !ok {
+			return strings.Join(strings.Fields(content), " ")
+		}
+		return flags + " " + strings.ToLower(tag) + " " + strconv.Quote(UnquoteRecordText(strings.TrimSpace(value)))
+
+	default:
+		return strings.Join(strings.Fields(content), " ")
+	}
+}
+
+// normalizeHost returns the host name lowercase without the trailing dot, except for the root ".".
+func normalizeHost(host string) string {
+	host = strings.ToLower(host)
+	if host == "." {
+		return host
+	}
+	return strings.TrimSuffix(host, ".")
+}
+
+// recordHasPriority reports whether the priority is part of the records of the type.
+func recordHasPriority(recordType string) bool {
+	recordType = strings.ToUpper(recordType)
+	return recordType == "MX" || recordType == "SRV"
+}
+
+// Normalize returns the record in its canonical form, where the attributes served by DNSimple
+// have a single representation: the name as returned by NormalizeRecordName for the zone ZoneID, the type uppercase,
+// the content as returned by NormalizeRecordContent, the priority 0 for the types without priority,
+// the default TTL and regions of the API when not set, and the regions sorted.
+// The other attributes, such as the ID, are unchanged.
+func (r ZoneRecord) Normalize() ZoneRecord {
+	r.Name = NormalizeRecordName(r.Name, r.ZoneID)
+	r.Type = strings.ToUpper(r.Type)
+	r.Content = NormalizeRecordContent(r.Type, r.Content)
+	if !recordHasPriority(r.Type) {
+		r.Priority = 0
+	}
+	if r.TTL == 0 {
+		r.TTL = defaultRecordTTL
+	}
+	if len(r.Regions) == 0 {
+		r.Regions = []string{defaultRecordRegion}
+	} else {
+		r.Regions = slices.Clone(r.Regions)
+		slices.Sort(r.Regions)
+	}
+	return r
+}
+
+// Key returns the identity of the record: its normalized name, type, priority and content,
+// e.g. "@ MX 10 mx.example.com". Two records with the same key are the same record,
+// even if their TTL or regions differ.
+func (r ZoneRecord) Key() string {
+	r = r.Normalize()
+	key := r.SetKey()
+	if recordHasPriority(r.Type) {
+		key += " " + strconv.Itoa(r.Priority)
+	}
+	return key + " " + r.Content
+}
+
+// SetKey returns the identity of the record set of the record: its normalized name and type, e.g. "www A".
+func (r ZoneRecord) SetKey() string {
+	name := NormalizeRecordName(r.Name, r.ZoneID)
+	if name == "" {
+		name = "@"
+	}
+	return name + " " + strings.ToUpper(r.Type)
+}
+
+// Equal reports whether the records are the same record, with the same TTL and regions.
+// The IDs, zones and timestamps are not compared.
+func (r ZoneRecord) Equal(other ZoneRecord) bool {
+	a, b := r.Normalize(), other.Normalize()
+	return a.Key() == b.Key() && a.TTL == b.TTL && slices.Equal(a.Regions, b.Regions)
+}
+
+// ZoneRecordSet represents the records of a zone with the same name and type.
+type ZoneRecordSet []ZoneRecord
+
+// GroupRecordSets groups the records by record set, in the order of their first record.
+func GroupRecordSets(records []ZoneRecord) []ZoneRecordSet {
+	var sets []ZoneRecordSet
+	index := map[string]int{}
+	for _, record := range records {
+		key := record.SetKey()
+		i, ok := index[key]
+		if !ok {
+			i = len(sets)
+			index[key] = i
+			sets = append(sets, nil)
+		}
+		sets[i] = append(sets[i], record)
+	}
+	return sets
+}
+
+// Key returns the identity of the record set, its normalized name and type, or "" for an empty set.
+func (s ZoneRecordSet) Key() string {
+	if len(s) == 0 {
+		return ""
+	}
+	return s[0].SetKey()
+}
+
+// Equal reports whether the record sets have the same records, as defined by ZoneRecord.Equal,
+// regardless of their order. The duplicate records of a set count once.
+func (s ZoneRecordSet) Equal(other ZoneRecordSet) bool {
+	return slices.Equal(s.canonical(), other.canonical())
+}
+
+// canonical returns the normalized records of the set, sorted and without the duplicates.
+func (s ZoneRecordSet) canonical() []string {
+	records := make([]string, 0, len(s))
+	for _, record := range s {
+		record = record.Normalize()
+		records = append(records, record.Key()+" "+strconv.Itoa(record.TTL)+" "+strings.Join(record.Regions, ","))
+	}
+	slices.Sort(records)
+	return slices.Compact(records)
+}
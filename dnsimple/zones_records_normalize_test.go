@@ -0,0 +1,134 @@
+package dnsimple
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeRecordName(t *testing.T) {
+	tests := []struct {
+		name, zone, want string
+	}{
+		{"", "example.com", ""},
+		{"@", "example.com", ""},
+		{"WWW", "example.com", "www"},
+		{"www.example.com.", "example.com", "www"},
+		{"www.Example.com.", "example.com.", "www"},
+		{"www.example.com", "example.com", "www.example.com"},
+		{"example.com.", "example.com", ""},
+		{"example.com", "example.com", "example.com"},
+		{"a.b", "example.com", "a.b"},
+		{"www.example.org.", "example.com", "www.example.org."},
+		{"Www.", "", "www"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, NormalizeRecordName(tt.name, tt.zone), "%q in %q", tt.name, tt.zone)
+	}
+}
+
+func TestNormalizeRecordContent(t *testing.T) {
+	tests := []struct {
+		recordType, content, want string
+	}{
+		{"A", " 192.0.2.1 ", "192.0.2.1"},
+		{"AAAA", "2001:0DB8:0000:0000:0000:0000:0000:0001", "2001:db8::1"},
+		{"aaaa", "2001:db8::1", "2001:db8::1"},
+		{"AAAA", "::FFFF:192.0.2.1", "::ffff:192.0.2.1"},
+		{"AAAA", "not an address", "not an address"},
+		{"CNAME", "Example.COM.", "example.com"},
+		{"MX", "mx.example.com.", "mx.example.com"},
+		{"NS", ".", "."},
+		{"SRV", "60  5060 SIP.example.com.", "60 5060 sip.example.com"},
+		{"TXT", "v=spf1 -all", "v=spf1 -all"},
+		{"TXT", `"v=spf1 -all"`, "v=spf1 -all"},
+		{"TXT", `"v=DKIM1; k=rsa; " "p=MIGf"`, "v=DKIM1; k=rsa; p=MIGf"},
+		{"TXT", `"say \"hello\""`, `say "hello"`},
+		{"TXT", `"unterminated`, `"unterminated`},
+		{"SPF", `"v=spf1" " mx"`, "v=spf1 mx"},
+		{"CAA", `0 ISSUE letsencrypt.org`, `0 issue "letsencrypt.org"`},
+		{"CAA", `0  issue  "letsencrypt.org"`, `0 issue "letsencrypt.org"`},
+		{"SSHFP", "1  1   abcdef", "1 1 abcdef"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, NormalizeRecordContent(tt.recordType, tt.content), "%s %q", tt.recordType, tt.content)
+	}
+}
+
+func TestZoneRecord_Normalize(t *testing.T) {
+	record := ZoneRecord{ID: 1, ZoneID: "example.com", Name: "WWW.example.com.", Type: "cname", Content: "Example.com.", Priority: 10, Regions: []string{"SV1", "IAD"}}
+
+	assert.Equal(t, ZoneRecord{ID: 1, ZoneID: "example.com", Name: "www", Type: "CNAME", Content: "example.com", TTL: 3600, Regions: []string{"IAD", "SV1"}}, record.Normalize())
+
+	// A relative name that ends with the zone is a distinct record.
+	relative := ZoneRecord{ZoneID: "example.com", Name: "www.example.com", Type: "CNAME", Content: "example.com"}
+	assert.Equal(t, "www.example.com", relative.Normalize().Name)
+	assert.False(t, relative.Equal(ZoneRecord{ZoneID: "example.com", Name: "www", Type: "CNAME", Content: "example.com"}))
+	assert.Equal(t, "SV1", record.Regions[0], "the regions of the record are unchanged")
+}
+
+func TestZoneRecord_Key(t *testing.T) {
+	assert.Equal(t, "www A 192.0.2.1", ZoneRecord{Name: "www", Type: "A", Content: "192.0.2.1"}.Key())
+	assert.Equal(t, "@ MX 10 mx.example.com", ZoneRecord{Type: "MX", Priority: 10, Content: "MX.example.com."}.Key())
+	assert.Equal(t, "@ MX", ZoneRecord{ZoneID: "example.com", Name: "example.com.", Type: "mx"}.SetKey())
+
+	// The TTL and the regions are not part of the identity.
+	assert.Equal(t,
+		ZoneRecord{Name: "www", Type: "A", Content: "192.0.2.1", TTL: 60}.Key(),
+		ZoneRecord{Name: "www", Type: "A", Content: "192.0.2.1", Regions: []string{"SV1"}}.Key())
+}
+
+func TestZoneRecord_Equal(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b ZoneRecord
+		want bool
+	}{
+		{"name case", ZoneRecord{Name: "WWW", Type: "A", Content: "192.0.2.1"}, ZoneRecord{Name: "www", Type: "A", Content: "192.0.2.1"}, true},
+		{"fqdn", ZoneRecord{ZoneID: "example.com", Name: "www.example.com.", Type: "A", Content: "192.0.2.1"}, ZoneRecord{ZoneID: "example.com", Name: "www", Type: "A", Content: "192.0.2.1"}, true},
+		{"apex", ZoneRecord{Name: "@", Type: "A", Content: "192.0.2.1"}, ZoneRecord{Name: "", Type: "A", Content: "192.0.2.1"}, true},
+		{"trailing dot", ZoneRecord{Type: "MX", Priority: 10, Content: "mx.example.com."}, ZoneRecord{Type: "MX", Priority: 10, Content: "mx.example.com"}, true},
+		{"txt chunks", ZoneRecord{Type: "TXT", Content: `"a" "b"`}, ZoneRecord{Type: "TXT", Content: "ab"}, true},
+		{"ipv6", ZoneRecord{Type: "AAAA", Content: "2001:db8:0:0:0:0:0:1"}, ZoneRecord{Type: "AAAA", Content: "2001:db8::1"}, true},
+		{"default ttl", ZoneRecord{Type: "A", Content: "192.0.2.1"}, ZoneRecord{Type: "A", Content: "192.0.2.1", TTL: 3600, Regions: []string{"global"}}, true},
+		{"ignored priority", ZoneRecord{Type: "A", Content: "192.0.2.1", Priority: 10}, ZoneRecord{Type: "A", Content: "192.0.2.1"}, true},
+		{"ids", ZoneRecord{ID: 1, Type: "A", Content: "192.0.2.1"}, ZoneRecord{ID: 2, Type: "A", Content: "192.0.2.1"}, true},
+		{"ttl", ZoneRecord{Type: "A", Content: "192.0.2.1", TTL: 60}, ZoneRecord{Type: "A", Content: "192.0.2.1"}, false},
+		{"priority", ZoneRecord{Type: "MX", Priority: 10, Content: "mx.example.com"}, ZoneRecord{Type: "MX", Priority: 20, Content: "mx.example.com"}, false},
+		{"content", ZoneRecord{Type: "A", Content: "192.0.2.1"}, ZoneRecord{Type: "A", Content: "192.0.2.2"}, false},
+		{"type", ZoneRecord{Type: "A", Content: "192.0.2.1"}, ZoneRecord{Type: "AAAA", Content: "192.0.2.1"}, false},
+		{"regions", ZoneRecord{Type: "A", Content: "192.0.2.1", Regions: []string{"SV1"}}, ZoneRecord{Type: "A", Content: "192.0.2.1"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.a.Equal(tt.b))
+			assert.Equal(t, tt.want, tt.b.Equal(tt.a))
+		})
+	}
+}
+
+func TestZoneRecordSet(t *testing.T) {
+	records := []ZoneRecord{
+		{Name: "", Type: "MX", Priority: 10, Content: "mx1.example.com"},
+		{Name: "www", Type: "A", Content: "192.0.2.1"},
+		{Name: "@", Type: "MX", Priority: 20, Content: "mx2.example.com."},
+		{Name: "WWW", Type: "A", Content: "192.0.2.2"},
+	}
+
+	sets := GroupRecordSets(records)
+
+	assert.Equal(t, []ZoneRecordSet{{records[0], records[2]}, {records[1], records[3]}}, sets)
+	assert.Equal(t, "@ MX", sets[0].Key())
+	assert.Equal(t, "", ZoneRecordSet{}.Key())
+
+	assert.True(t, sets[0].Equal(ZoneRecordSet{
+		{Type: "MX", Priority: 20, Content: "MX2.example.com"},
+		{Type: "MX", Priority: 10, Content: "mx1.example.com."},
+		{Type: "MX", Priority: 10, Content: "mx1.example.com"},
+	}))
+	assert.False(t, sets[0].Equal(ZoneRecordSet{{Type: "MX", Priority: 10, Content: "mx1.example.com"}}))
+	assert.False(t, sets[1].Equal(sets[0]))
+}
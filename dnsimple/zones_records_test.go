@@ -2,6 +2,7 @@ package dnsimple
 
 import (
 	"context"
+	"errors"
 	"io"
 	"net/http"
 	"net/url"
@@ -65,6 +66,30 @@ func TestZonesService_ListRecords_WithOptions(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestZonesService_ListRecordsIter(t *testing.T) {
+	setupMockServer()
+	defer teardownMockServer()
+
+	mux.HandleFunc("/v2/1010/zones/example.com/records", func(w http.ResponseWriter, r *http.Request) {
+		httpResponse := httpResponseFixture(t, "/api/listZoneRecords/success.http")
+
+		testMethod(t, r, "GET")
+		testQuery(t, r, url.Values{
+			"page": []string{"1"},
+			"type": []string{"A"},
+		})
+
+		w.WriteHeader(httpResponse.StatusCode)
+		_, _ = io.Copy(w, httpResponse.Body)
+	})
+
+	records, err := All(client.Zones.ListRecordsIter(context.Background(), "1010", "example.com", &ZoneRecordListOptions{Type: String("A")}))
+
+	assert.NoError(t, err)
+	assert.Len(t, records, 5)
+	assert.Equal(t, int64(1), records[0].ID)
+}
+
 func TestZonesService_ListRecords_WithOptionsSomeBlank(t *testing.T) {
 	setupMockServer()
 	defer teardownMockServer()
@@ -465,6 +490,17 @@ func TestZonesService_BatchChangeZoneRecords_CreateValidationFailed(t *testing.T
 	assert.Contains(t, got.AttributeErrors, "creates[0]")
 	assert.Equal(t, []string{"Validation failed"}, got.AttributeErrors["creates[0]"])
 	assert.Equal(t, []string{"unsupported"}, got.AttributeErrors["creates[0].record_type"])
+
+	var batchErr *BatchChangeError
+	assert.ErrorAs(t, err, &batchErr)
+	assert.ErrorIs(t, err, ErrValidation)
+	assert.Len(t, batchErr.Creates, 1)
+	assert.Empty(t, batchErr.Updates)
+	assert.Empty(t, batchErr.Deletes)
+	assert.Equal(t, 0, batchErr.Creates[0].Index)
+	assert.Same(t, &batchRequest.Creates[0], batchErr.Creates[0].Record)
+	assert.Equal(t, "Validation failed", batchErr.Creates[0].Message)
+	assert.Equal(t, map[string][]string{"record_type": {"unsupported"}}, batchErr.Creates[0].Errors)
 }
 
 func TestZonesService_BatchChangeZoneRecords_UpdateValidationFailed(t *testing.T) {
@@ -495,6 +531,12 @@ func TestZonesService_BatchChangeZoneRecords_UpdateValidationFailed(t *testing.T
 	assert.Equal(t, "Validation failed", got.Message)
 	assert.Contains(t, got.AttributeErrors, "updates[0]")
 	assert.Equal(t, []string{"Record not found ID=99999999"}, got.AttributeErrors["updates[0]"])
+
+	var batchErr *BatchChangeError
+	assert.ErrorAs(t, err, &batchErr)
+	assert.Len(t, batchErr.Updates, 1)
+	assert.Same(t, &batchRequest.Updates[0], batchErr.Updates[0].Record)
+	assert.Equal(t, "Record not found ID=99999999", batchErr.Updates[0].Message)
 }
 
 func TestZonesService_BatchChangeZoneRecords_DeleteValidationFailed(t *testing.T) {
@@ -525,4 +567,47 @@ func TestZonesService_BatchChangeZoneRecords_DeleteValidationFailed(t *testing.T
 	assert.Equal(t, "Validation failed", got.Message)
 	assert.Contains(t, got.AttributeErrors, "deletes[0]")
 	assert.Equal(t, []string{"Record not found ID=67622509"}, got.AttributeErrors["deletes[0]"])
+
+	var batchErr *BatchChangeError
+	assert.ErrorAs(t, err, &batchErr)
+	assert.Len(t, batchErr.Deletes, 1)
+	assert.Equal(t, int64(67622509), batchErr.Deletes[0].Record.ID)
+	assert.Equal(t, "Record not found ID=67622509", batchErr.Deletes[0].Message)
+}
+
+func TestZonesService_BatchChangeZoneRecords_IndexOutOfRange(t *testing.T) {
+	setupMockServer()
+	defer teardownMockServer()
+
+	mux.HandleFunc("/v2/1010/zones/example.com/batch", func(w http.ResponseWriter, _ *http.Request) {
+		httpResponse := httpResponseFixture(t, "/api/batchChangeZoneRecords/error_400_delete_validation_failed.http")
+
+		w.WriteHeader(httpResponse.StatusCode)
+		_, _ = io.Copy(w, httpResponse.Body)
+	})
+
+	_, err := client.Zones.BatchChangeZoneRecords(context.Background(), "1010", "example.com", BatchChangeZoneRecordsRequest{})
+
+	var batchErr *BatchChangeError
+	assert.ErrorAs(t, err, &batchErr)
+	assert.Len(t, batchErr.Deletes, 1)
+	assert.Nil(t, batchErr.Deletes[0].Record)
+}
+
+func TestZonesService_BatchChangeZoneRecords_NotFound(t *testing.T) {
+	setupMockServer()
+	defer teardownMockServer()
+
+	mux.HandleFunc("/v2/1010/zones/example.com/batch", func(w http.ResponseWriter, _ *http.Request) {
+		httpResponse := httpResponseFixture(t, "/api/notfound-zone.http")
+
+		w.WriteHeader(httpResponse.StatusCode)
+		_, _ = io.Copy(w, httpResponse.Body)
+	})
+
+	_, err := client.Zones.BatchChangeZoneRecords(context.Background(), "1010", "example.com", BatchChangeZoneRecordsRequest{})
+
+	var batchErr *BatchChangeError
+	assert.False(t, errors.As(err, &batchErr))
+	assert.ErrorIs(t, err, ErrNotFound)
 }